@@ -0,0 +1,1759 @@
+//go:build go1.22
+
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.8.0 DO NOT EDIT.
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for APIKeyScope.
+const (
+	APIKeyScopeAdminTeam APIKeyScope = "admin:team"
+	APIKeyScopeRead      APIKeyScope = "read"
+	APIKeyScopeWritePr   APIKeyScope = "write:pr"
+)
+
+// Valid indicates whether the value is a known member of the APIKeyScope enum.
+func (e APIKeyScope) Valid() bool {
+	switch e {
+	case APIKeyScopeAdminTeam:
+		return true
+	case APIKeyScopeRead:
+		return true
+	case APIKeyScopeWritePr:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssignmentEventType.
+const (
+	AssignmentEventTypeASSIGNED   AssignmentEventType = "ASSIGNED"
+	AssignmentEventTypeMERGED     AssignmentEventType = "MERGED"
+	AssignmentEventTypeREASSIGNED AssignmentEventType = "REASSIGNED"
+)
+
+// Valid indicates whether the value is a known member of the AssignmentEventType enum.
+func (e AssignmentEventType) Valid() bool {
+	switch e {
+	case AssignmentEventTypeASSIGNED:
+		return true
+	case AssignmentEventTypeMERGED:
+		return true
+	case AssignmentEventTypeREASSIGNED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	ErrorResponseErrorCodeBADREQUEST           ErrorResponseErrorCode = "BAD_REQUEST"
+	ErrorResponseErrorCodeFORBIDDEN            ErrorResponseErrorCode = "FORBIDDEN"
+	ErrorResponseErrorCodeIDEMPOTENCYKEYREUSED ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	ErrorResponseErrorCodeINTERNALERROR        ErrorResponseErrorCode = "INTERNAL_ERROR"
+	ErrorResponseErrorCodeNOCANDIDATE          ErrorResponseErrorCode = "NO_CANDIDATE"
+	ErrorResponseErrorCodeNOTASSIGNED          ErrorResponseErrorCode = "NOT_ASSIGNED"
+	ErrorResponseErrorCodeNOTFOUND             ErrorResponseErrorCode = "NOT_FOUND"
+	ErrorResponseErrorCodeOVERLOADED           ErrorResponseErrorCode = "OVERLOADED"
+	ErrorResponseErrorCodePAYLOADTOOLARGE      ErrorResponseErrorCode = "PAYLOAD_TOO_LARGE"
+	ErrorResponseErrorCodePREXISTS             ErrorResponseErrorCode = "PR_EXISTS"
+	ErrorResponseErrorCodePRMERGED             ErrorResponseErrorCode = "PR_MERGED"
+	ErrorResponseErrorCodeREQUESTINPROGRESS    ErrorResponseErrorCode = "REQUEST_IN_PROGRESS"
+	ErrorResponseErrorCodeTEAMARCHIVED         ErrorResponseErrorCode = "TEAM_ARCHIVED"
+	ErrorResponseErrorCodeTEAMEXISTS           ErrorResponseErrorCode = "TEAM_EXISTS"
+	ErrorResponseErrorCodeTEAMHASOPENPRS       ErrorResponseErrorCode = "TEAM_HAS_OPEN_PRS"
+	ErrorResponseErrorCodeTOOMANYREQUESTS      ErrorResponseErrorCode = "TOO_MANY_REQUESTS"
+	ErrorResponseErrorCodeUNAUTHORIZED         ErrorResponseErrorCode = "UNAUTHORIZED"
+	ErrorResponseErrorCodeUNSUPPORTEDMEDIATYPE ErrorResponseErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrorResponseErrorCodeUSERINOTHERTEAM      ErrorResponseErrorCode = "USER_IN_OTHER_TEAM"
+)
+
+// Valid indicates whether the value is a known member of the ErrorResponseErrorCode enum.
+func (e ErrorResponseErrorCode) Valid() bool {
+	switch e {
+	case ErrorResponseErrorCodeBADREQUEST:
+		return true
+	case ErrorResponseErrorCodeFORBIDDEN:
+		return true
+	case ErrorResponseErrorCodeIDEMPOTENCYKEYREUSED:
+		return true
+	case ErrorResponseErrorCodeINTERNALERROR:
+		return true
+	case ErrorResponseErrorCodeNOCANDIDATE:
+		return true
+	case ErrorResponseErrorCodeNOTASSIGNED:
+		return true
+	case ErrorResponseErrorCodeNOTFOUND:
+		return true
+	case ErrorResponseErrorCodeOVERLOADED:
+		return true
+	case ErrorResponseErrorCodePAYLOADTOOLARGE:
+		return true
+	case ErrorResponseErrorCodePREXISTS:
+		return true
+	case ErrorResponseErrorCodePRMERGED:
+		return true
+	case ErrorResponseErrorCodeREQUESTINPROGRESS:
+		return true
+	case ErrorResponseErrorCodeTEAMARCHIVED:
+		return true
+	case ErrorResponseErrorCodeTEAMEXISTS:
+		return true
+	case ErrorResponseErrorCodeTEAMHASOPENPRS:
+		return true
+	case ErrorResponseErrorCodeTOOMANYREQUESTS:
+		return true
+	case ErrorResponseErrorCodeUNAUTHORIZED:
+		return true
+	case ErrorResponseErrorCodeUNSUPPORTEDMEDIATYPE:
+		return true
+	case ErrorResponseErrorCodeUSERINOTHERTEAM:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for HealthReportChecksStatus.
+const (
+	HealthReportChecksStatusFail HealthReportChecksStatus = "fail"
+	HealthReportChecksStatusOk   HealthReportChecksStatus = "ok"
+)
+
+// Valid indicates whether the value is a known member of the HealthReportChecksStatus enum.
+func (e HealthReportChecksStatus) Valid() bool {
+	switch e {
+	case HealthReportChecksStatusFail:
+		return true
+	case HealthReportChecksStatusOk:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusFail HealthReportStatus = "fail"
+	HealthReportStatusOk   HealthReportStatus = "ok"
+)
+
+// Valid indicates whether the value is a known member of the HealthReportStatus enum.
+func (e HealthReportStatus) Valid() bool {
+	switch e {
+	case HealthReportStatusFail:
+		return true
+	case HealthReportStatusOk:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestStatus enum.
+func (e PullRequestStatus) Valid() bool {
+	switch e {
+	case PullRequestStatusMERGED:
+		return true
+	case PullRequestStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestShortStatus enum.
+func (e PullRequestShortStatus) Valid() bool {
+	switch e {
+	case PullRequestShortStatusMERGED:
+		return true
+	case PullRequestShortStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ReviewChangeAction.
+const (
+	ReviewChangeActionKEPT       ReviewChangeAction = "KEPT"
+	ReviewChangeActionREASSIGNED ReviewChangeAction = "REASSIGNED"
+	ReviewChangeActionREMOVED    ReviewChangeAction = "REMOVED"
+)
+
+// Valid indicates whether the value is a known member of the ReviewChangeAction enum.
+func (e ReviewChangeAction) Valid() bool {
+	switch e {
+	case ReviewChangeActionKEPT:
+		return true
+	case ReviewChangeActionREASSIGNED:
+		return true
+	case ReviewChangeActionREMOVED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SyncActionAction.
+const (
+	SyncActionActionCREATETEAM     SyncActionAction = "CREATE_TEAM"
+	SyncActionActionCREATEUSER     SyncActionAction = "CREATE_USER"
+	SyncActionActionDEACTIVATEUSER SyncActionAction = "DEACTIVATE_USER"
+	SyncActionActionMOVEUSER       SyncActionAction = "MOVE_USER"
+	SyncActionActionUPDATEUSER     SyncActionAction = "UPDATE_USER"
+)
+
+// Valid indicates whether the value is a known member of the SyncActionAction enum.
+func (e SyncActionAction) Valid() bool {
+	switch e {
+	case SyncActionActionCREATETEAM:
+		return true
+	case SyncActionActionCREATEUSER:
+		return true
+	case SyncActionActionDEACTIVATEUSER:
+		return true
+	case SyncActionActionMOVEUSER:
+		return true
+	case SyncActionActionUPDATEUSER:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListPullRequestsParamsStatus.
+const (
+	ListPullRequestsParamsStatusMERGED ListPullRequestsParamsStatus = "MERGED"
+	ListPullRequestsParamsStatusOPEN   ListPullRequestsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the ListPullRequestsParamsStatus enum.
+func (e ListPullRequestsParamsStatus) Valid() bool {
+	switch e {
+	case ListPullRequestsParamsStatusMERGED:
+		return true
+	case ListPullRequestsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUserReviewsParamsStatus.
+const (
+	GetUserReviewsParamsStatusMERGED GetUserReviewsParamsStatus = "MERGED"
+	GetUserReviewsParamsStatusOPEN   GetUserReviewsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetUserReviewsParamsStatus enum.
+func (e GetUserReviewsParamsStatus) Valid() bool {
+	switch e {
+	case GetUserReviewsParamsStatusMERGED:
+		return true
+	case GetUserReviewsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUserReviewsParamsOrder.
+const (
+	GetUserReviewsParamsOrderAsc  GetUserReviewsParamsOrder = "asc"
+	GetUserReviewsParamsOrderDesc GetUserReviewsParamsOrder = "desc"
+)
+
+// Valid indicates whether the value is a known member of the GetUserReviewsParamsOrder enum.
+func (e GetUserReviewsParamsOrder) Valid() bool {
+	switch e {
+	case GetUserReviewsParamsOrderAsc:
+		return true
+	case GetUserReviewsParamsOrderDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUserReviewsParamsInclude.
+const (
+	GetUserReviewsParamsIncludeDetails GetUserReviewsParamsInclude = "details"
+)
+
+// Valid indicates whether the value is a known member of the GetUserReviewsParamsInclude enum.
+func (e GetUserReviewsParamsInclude) Valid() bool {
+	switch e {
+	case GetUserReviewsParamsIncludeDetails:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey defines model for ApiKey.
+type APIKey struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// KeyID Example: 3f9a1c2b7d4e5f60
+	KeyID string `json:"key_id"`
+
+	// Name Кому выдан ключ
+	//
+	// Example: ci
+	Name string `json:"name"`
+
+	// OrgID Организация, с данными которой работает ключ
+	//
+	// Example: default
+	OrgID string `json:"org_id"`
+
+	// RevokedAt Время отзыва, отсутствует у действующего ключа
+	RevokedAt *time.Time    `json:"revoked_at,omitempty"`
+	Scopes    []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyScope defines model for ApiKeyScope.
+type APIKeyScope string
+
+// ArchivedTeam defines model for ArchivedTeam.
+type ArchivedTeam struct {
+	// AffectedPullRequests Открытые ревью, с которых сняты участники команды
+	AffectedPullRequests []ReviewChange `json:"affected_pull_requests"`
+	Archived             *bool          `json:"archived,omitempty"`
+	Members              []TeamMember   `json:"members"`
+	TeamName             string         `json:"team_name"`
+}
+
+// AssignmentEvent defines model for AssignmentEvent.
+type AssignmentEvent struct {
+	AuthorID string `json:"author_id"`
+
+	// PreviousReviewerID Заменённый ревьювер, только у REASSIGNED
+	PreviousReviewerID *string `json:"previous_reviewer_id,omitempty"`
+	PullRequestID      string  `json:"pull_request_id"`
+
+	// ReviewerID Назначенный ревьювер, у MERGED — ревьювер слитого PR (событие на каждого)
+	ReviewerID *string `json:"reviewer_id,omitempty"`
+
+	// TeamName Команда ревьюверов PR
+	TeamName string    `json:"team_name"`
+	Time     time.Time `json:"time"`
+
+	// Type `ASSIGNED` — ревьювер назначен на новый PR, `REASSIGNED` — ревьювер заменён, `MERGED` — PR слит
+	Type AssignmentEventType `json:"type"`
+}
+
+// AssignmentEventType `ASSIGNED` — ревьювер назначен на новый PR, `REASSIGNED` — ревьювер заменён, `MERGED` — PR слит
+type AssignmentEventType string
+
+// ErrorResponse Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Ошибки отдельных полей запроса, заполняется для BAD_REQUEST
+		Fields  *[]FieldError `json:"fields,omitempty"`
+		Message string        `json:"message"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю, элементы массивов нумеруются с нуля
+	//
+	// Example: members.1.user_id
+	Field string `json:"field"`
+
+	// Message Example: duplicate user_id
+	Message string `json:"message"`
+}
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	Checks []struct {
+		Error  *string                  `json:"error,omitempty"`
+		Name   string                   `json:"name"`
+		Status HealthReportChecksStatus `json:"status"`
+	} `json:"checks"`
+	Status HealthReportStatus `json:"status"`
+}
+
+// HealthReportChecksStatus defines model for HealthReport.Checks.Status.
+type HealthReportChecksStatus string
+
+// HealthReportStatus defines model for HealthReport.Status.
+type HealthReportStatus string
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	CreatedTeams []string `json:"created_teams"`
+
+	// Errors Ошибки по строкам файла; при наличии ошибок ничего не импортируется
+	Errors *[]struct {
+		// Code `BAD_REQUEST` — неверное значение, `USER_IN_OTHER_TEAM` — пользователь состоит в другой команде, `TEAM_ARCHIVED` — команда архивирована
+		Code    *string `json:"code,omitempty"`
+		Message string  `json:"message"`
+		Row     int     `json:"row"`
+	} `json:"errors,omitempty"`
+	Imported int `json:"imported"`
+}
+
+// MembershipChange defines model for MembershipChange.
+type MembershipChange struct {
+	AffectedPullRequests []ReviewChange `json:"affected_pull_requests"`
+	TeamName             string         `json:"team_name"`
+	Users                []User         `json:"users"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+	AuthorID          string            `json:"author_id"`
+	CreatedAt         *time.Time        `json:"createdAt,omitempty"`
+	MergedAt          *time.Time        `json:"mergedAt,omitempty"`
+	PullRequestID     string            `json:"pull_request_id"`
+	PullRequestName   string            `json:"pull_request_name"`
+	Status            PullRequestStatus `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestPage defines model for PullRequestPage.
+type PullRequestPage struct {
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor   *string       `json:"next_cursor,omitempty"`
+	PullRequests []PullRequest `json:"pull_requests"`
+
+	// Total Общее количество PR, подходящих под фильтры
+	Total int `json:"total"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	// AssignedReviewers Только при include=details
+	AssignedReviewers *[]string `json:"assigned_reviewers,omitempty"`
+	AuthorID          string    `json:"author_id"`
+
+	// CreatedAt Только при include=details
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// MergedAt Только при include=details
+	MergedAt        *time.Time             `json:"mergedAt,omitempty"`
+	PullRequestID   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// ReviewChange defines model for ReviewChange.
+type ReviewChange struct {
+	Action ReviewChangeAction `json:"action"`
+
+	// NewUserID Новый ревьювер, только для REASSIGNED
+	NewUserID     *string `json:"new_user_id,omitempty"`
+	OldUserID     string  `json:"old_user_id"`
+	PullRequestID string  `json:"pull_request_id"`
+}
+
+// ReviewChangeAction defines model for ReviewChange.Action.
+type ReviewChangeAction string
+
+// Roster defines model for Roster.
+type Roster struct {
+	Teams []Team `json:"teams"`
+}
+
+// SyncAction defines model for SyncAction.
+type SyncAction struct {
+	Action SyncActionAction `json:"action"`
+
+	// FromTeam Прежняя команда, только для MOVE_USER
+	FromTeam *string `json:"from_team,omitempty"`
+	TeamName string  `json:"team_name"`
+	UserID   *string `json:"user_id,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// SyncActionAction defines model for SyncAction.Action.
+type SyncActionAction string
+
+// SyncPlan defines model for SyncPlan.
+type SyncPlan struct {
+	Actions []SyncAction `json:"actions"`
+
+	// AffectedPullRequests Переназначенные ревью деактивированных пользователей (пусто при dry_run)
+	AffectedPullRequests []ReviewChange `json:"affected_pull_requests"`
+	DryRun               bool           `json:"dry_run"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// Archived Присутствует только у архивных команд
+	Archived *bool        `json:"archived,omitempty"`
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool `json:"is_active"`
+
+	// TeamName Отсутствует у пользователей без команды
+	TeamName *string `json:"team_name,omitempty"`
+	UserID   string  `json:"user_id"`
+	Username string  `json:"username"`
+}
+
+// PullRequestIDQuery defines model for PullRequestIdQuery.
+type PullRequestIDQuery = string
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIDQuery defines model for UserIdQuery.
+type UserIDQuery = string
+
+// Error Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type Error = ErrorResponse
+
+// CreateAPIKeyJSONBody defines parameters for CreateAPIKey.
+type CreateAPIKeyJSONBody struct {
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+}
+
+// RevokeAPIKeyJSONBody defines parameters for RevokeAPIKey.
+type RevokeAPIKeyJSONBody struct {
+	KeyID string `json:"key_id"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// UserID Автор, ревьювер или заменённый ревьювер
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Команда ревьюверов
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// ImportUsers400JSONResponseBody defines parameters for ImportUsers.
+type ImportUsers400JSONResponseBody struct {
+	union json.RawMessage
+}
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	AuthorID        string `json:"author_id"`
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// GetPullRequestParams defines parameters for GetPullRequest.
+type GetPullRequestParams struct {
+	// PullRequestID Идентификатор PR
+	PullRequestID PullRequestIDQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// IfNoneMatch ETag из предыдущего ответа
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// ListPullRequestsParams defines parameters for ListPullRequests.
+type ListPullRequestsParams struct {
+	Status     *ListPullRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorID   *string                       `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerID *string                       `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Name Подстрока названия PR
+	Name        *string    `form:"name,omitempty" json:"name,omitempty"`
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom  *time.Time `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+	MergedTo    *time.Time `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+	Limit       *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor      *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListPullRequestsParamsStatus defines parameters for ListPullRequests.
+type ListPullRequestsParamsStatus string
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
+	PullRequestID string `json:"pull_request_id"`
+}
+
+// ReassignPullRequestJSONBody defines parameters for ReassignPullRequest.
+type ReassignPullRequestJSONBody struct {
+	OldUserID     string `json:"old_user_id"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
+// ArchiveTeamJSONBody defines parameters for ArchiveTeam.
+type ArchiveTeamJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// DeleteTeamJSONBody defines parameters for DeleteTeam.
+type DeleteTeamJSONBody struct {
+	TargetTeamName *string `json:"target_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// RemoveTeamMembersJSONBody defines parameters for RemoveTeamMembers.
+type RemoveTeamMembersJSONBody struct {
+	// ReassignReviews Переназначить открытые ревью внутри команды
+	ReassignReviews *bool    `json:"reassign_reviews,omitempty"`
+	TeamName        string   `json:"team_name"`
+	UserIds         []string `json:"user_ids"`
+}
+
+// RenameTeamJSONBody defines parameters for RenameTeam.
+type RenameTeamJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// SyncTeamsParams defines parameters for SyncTeams.
+type SyncTeamsParams struct {
+	// DryRun Только показать план изменений
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetUserReviewsParams defines parameters for GetUserReviews.
+type GetUserReviewsParams struct {
+	// UserID Идентификатор пользователя
+	UserID UserIDQuery                 `form:"user_id" json:"user_id"`
+	Status *GetUserReviewsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Order Сортировка по времени создания
+	Order *GetUserReviewsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Без параметра возвращаются все PR
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Include Добавить ревьюверов и временные метки
+	Include *GetUserReviewsParamsInclude `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// GetUserReviewsParamsStatus defines parameters for GetUserReviews.
+type GetUserReviewsParamsStatus string
+
+// GetUserReviewsParamsOrder defines parameters for GetUserReviews.
+type GetUserReviewsParamsOrder string
+
+// GetUserReviewsParamsInclude defines parameters for GetUserReviews.
+type GetUserReviewsParamsInclude string
+
+// MoveUserTeamJSONBody defines parameters for MoveUserTeam.
+type MoveUserTeamJSONBody struct {
+	// ReassignReviews Переназначить открытые ревью на участников прежней команды (если кандидатов нет, ревьювер снимается)
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+	TeamName        string `json:"team_name"`
+	UserID          string `json:"user_id"`
+}
+
+// SetUserIsActiveJSONBody defines parameters for SetUserIsActive.
+type SetUserIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserID   string `json:"user_id"`
+}
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody CreateAPIKeyJSONBody
+
+// RevokeAPIKeyJSONRequestBody defines body for RevokeAPIKey for application/json ContentType.
+type RevokeAPIKeyJSONRequestBody RevokeAPIKeyJSONBody
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignPullRequestJSONRequestBody defines body for ReassignPullRequest for application/json ContentType.
+type ReassignPullRequestJSONRequestBody ReassignPullRequestJSONBody
+
+// AddTeamJSONRequestBody defines body for AddTeam for application/json ContentType.
+type AddTeamJSONRequestBody = Team
+
+// AddTeamMembersJSONRequestBody defines body for AddTeamMembers for application/json ContentType.
+type AddTeamMembersJSONRequestBody = Team
+
+// ArchiveTeamJSONRequestBody defines body for ArchiveTeam for application/json ContentType.
+type ArchiveTeamJSONRequestBody ArchiveTeamJSONBody
+
+// DeleteTeamJSONRequestBody defines body for DeleteTeam for application/json ContentType.
+type DeleteTeamJSONRequestBody DeleteTeamJSONBody
+
+// RemoveTeamMembersJSONRequestBody defines body for RemoveTeamMembers for application/json ContentType.
+type RemoveTeamMembersJSONRequestBody RemoveTeamMembersJSONBody
+
+// RenameTeamJSONRequestBody defines body for RenameTeam for application/json ContentType.
+type RenameTeamJSONRequestBody RenameTeamJSONBody
+
+// SyncTeamsJSONRequestBody defines body for SyncTeams for application/json ContentType.
+type SyncTeamsJSONRequestBody = Roster
+
+// MoveUserTeamJSONRequestBody defines body for MoveUserTeam for application/json ContentType.
+type MoveUserTeamJSONRequestBody MoveUserTeamJSONBody
+
+// SetUserIsActiveJSONRequestBody defines body for SetUserIsActive for application/json ContentType.
+type SetUserIsActiveJSONRequestBody SetUserIsActiveJSONBody
+
+// AsImportResult returns the union data inside the ImportUsers400JSONResponseBody as a ImportResult
+func (t ImportUsers400JSONResponseBody) AsImportResult() (ImportResult, error) {
+	var body ImportResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromImportResult overwrites any union data inside the ImportUsers400JSONResponseBody as the provided ImportResult
+func (t *ImportUsers400JSONResponseBody) FromImportResult(v ImportResult) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeImportResult performs a merge with any union data inside the ImportUsers400JSONResponseBody, using the provided ImportResult
+func (t *ImportUsers400JSONResponseBody) MergeImportResult(v ImportResult) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorResponse returns the union data inside the ImportUsers400JSONResponseBody as a ErrorResponse
+func (t ImportUsers400JSONResponseBody) AsErrorResponse() (ErrorResponse, error) {
+	var body ErrorResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorResponse overwrites any union data inside the ImportUsers400JSONResponseBody as the provided ErrorResponse
+func (t *ImportUsers400JSONResponseBody) FromErrorResponse(v ErrorResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorResponse performs a merge with any union data inside the ImportUsers400JSONResponseBody, using the provided ErrorResponse
+func (t *ImportUsers400JSONResponseBody) MergeErrorResponse(v ErrorResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ImportUsers400JSONResponseBody) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ImportUsers400JSONResponseBody) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// CreateAPIKey Выпустить API-ключ
+	// (POST /apiKey/create)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// ListAPIKeys Список API-ключей, включая отозванные
+	// (GET /apiKey/list)
+	ListAPIKeys(w http.ResponseWriter, r *http.Request)
+	// RevokeAPIKey Отозвать API-ключ
+	// (POST /apiKey/revoke)
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request)
+	// StreamEvents Поток назначений, переназначений и слияний PR (Server-Sent Events)
+	// (GET /events/stream)
+	StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams)
+	// ExportPullRequests Выгрузить PR в CSV (ревьюверы через пробел)
+	// (GET /export/pullRequests)
+	ExportPullRequests(w http.ResponseWriter, r *http.Request)
+	// ExportUsers Выгрузить пользователей в CSV (формат совпадает с импортом)
+	// (GET /export/users)
+	ExportUsers(w http.ResponseWriter, r *http.Request)
+	// GetLiveness Проверка, что процесс жив (liveness)
+	// (GET /health/live)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	// GetReadiness Готовность принимать трафик (readiness)
+	// (GET /health/ready)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+	// ImportUsers Импортировать пользователей из CSV (создаёт недостающие команды)
+	// (POST /import/users)
+	ImportUsers(w http.ResponseWriter, r *http.Request)
+	// GetMetrics Метрики в формате Prometheus
+	// (GET /metrics)
+	GetMetrics(w http.ResponseWriter, r *http.Request)
+	// CreatePullRequest Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	CreatePullRequest(w http.ResponseWriter, r *http.Request)
+	// GetPullRequest Получить PR с ревьюверами
+	// (GET /pullRequest/get)
+	GetPullRequest(w http.ResponseWriter, r *http.Request, params GetPullRequestParams)
+	// ListPullRequests Получить список PR с фильтрами и постраничной выдачей (по времени создания)
+	// (GET /pullRequest/list)
+	ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams)
+	// MergePullRequest Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(w http.ResponseWriter, r *http.Request)
+	// ReassignPullRequest Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignPullRequest(w http.ResponseWriter, r *http.Request)
+	// AddTeam Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(w http.ResponseWriter, r *http.Request)
+	// AddTeamMembers Добавить участников в существующую команду
+	// (POST /team/addMembers)
+	AddTeamMembers(w http.ResponseWriter, r *http.Request)
+	// ArchiveTeam Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+	// (POST /team/archive)
+	ArchiveTeam(w http.ResponseWriter, r *http.Request)
+	// DeleteTeam Удалить команду
+	// (POST /team/delete)
+	DeleteTeam(w http.ResponseWriter, r *http.Request)
+	// GetTeam Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams)
+	// RemoveTeamMembers Исключить участников из команды (пользователи деактивируются)
+	// (POST /team/removeMembers)
+	RemoveTeamMembers(w http.ResponseWriter, r *http.Request)
+	// RenameTeam Переименовать команду (участники переходят под новое имя)
+	// (POST /team/rename)
+	RenameTeam(w http.ResponseWriter, r *http.Request)
+	// SyncTeams Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+	// (POST /team/sync)
+	SyncTeams(w http.ResponseWriter, r *http.Request, params SyncTeamsParams)
+	// GetUserReviews Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams)
+	// MoveUserTeam Перевести пользователя в другую команду
+	// (POST /users/moveTeam)
+	MoveUserTeam(w http.ResponseWriter, r *http.Request)
+	// SetUserIsActive Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserIsActive(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAPIKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "user_id", r.URL.Query(), &params.UserID, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) ExportPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPullRequests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportUsers operation middleware
+func (siw *ServerInterfaceWrapper) ExportUsers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportUsers operation middleware
+func (siw *ServerInterfaceWrapper) ImportUsers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMetrics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestID, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPullRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "author_id", r.URL.Query(), &params.AuthorID, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "author_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerID, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "reviewer_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "name", r.URL.Query(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "created_from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "created_to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "merged_from", r.URL.Query(), &params.MergedFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "merged_from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "merged_to", r.URL.Query(), &params.MergedTo, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "merged_to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignPullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeam operation middleware
+func (siw *ServerInterfaceWrapper) AddTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeamMembers operation middleware
+func (siw *ServerInterfaceWrapper) AddTeamMembers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeamMembers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveTeam operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTeamMembers operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTeamMembers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTeamMembers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameTeam operation middleware
+func (siw *ServerInterfaceWrapper) RenameTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SyncTeams operation middleware
+func (siw *ServerInterfaceWrapper) SyncTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncTeamsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dry_run"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetUserReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserReviewsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "user_id", r.URL.Query(), &params.UserID, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include", r.URL.Query(), &params.Include, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "include"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MoveUserTeam operation middleware
+func (siw *ServerInterfaceWrapper) MoveUserTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveUserTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserIsActive operation middleware
+func (siw *ServerInterfaceWrapper) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of [http.ServeMux].
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	http.Handler
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/team/add", wrapper.AddTeam)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/team/get", wrapper.GetTeam)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/team/rename", wrapper.RenameTeam)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/team/archive", wrapper.ArchiveTeam)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/team/delete", wrapper.DeleteTeam)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/team/addMembers", wrapper.AddTeamMembers)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/team/removeMembers", wrapper.RemoveTeamMembers)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users/moveTeam", wrapper.MoveUserTeam)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/team/sync", wrapper.SyncTeams)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users/setIsActive", wrapper.SetUserIsActive)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/pullRequest/create", wrapper.CreatePullRequest)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/pullRequest/merge", wrapper.MergePullRequest)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/pullRequest/reassign", wrapper.ReassignPullRequest)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/pullRequest/get", wrapper.GetPullRequest)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/pullRequest/list", wrapper.ListPullRequests)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/users/getReview", wrapper.GetUserReviews)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/events/stream", wrapper.StreamEvents)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/import/users", wrapper.ImportUsers)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/export/users", wrapper.ExportUsers)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/export/pullRequests", wrapper.ExportPullRequests)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/health/live", wrapper.GetLiveness)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/health/ready", wrapper.GetReadiness)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/metrics", wrapper.GetMetrics)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/apiKey/create", wrapper.CreateAPIKey)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/apiKey/revoke", wrapper.RevokeAPIKey)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/apiKey/list", wrapper.ListAPIKeys)
+
+	return m
+}
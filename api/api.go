@@ -0,0 +1,14 @@
+// Package api embeds OpenAPI specification of the service, so binary validates traffic against the same contract.
+// Server interface and models in api.gen.go are generated from the specification.
+// gRPC API is described in reviewmanager/v1/review_manager.proto, its code is generated into the same directory.
+package api
+
+import _ "embed"
+
+//go:generate go tool oapi-codegen -config oapi-codegen.yaml openapi.yml
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative reviewmanager/v1/review_manager.proto
+
+// Spec is OpenAPI specification in YAML.
+//
+//go:embed openapi.yml
+var Spec []byte
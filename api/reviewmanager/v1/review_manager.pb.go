@@ -0,0 +1,1985 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: reviewmanager/v1/review_manager.proto
+
+// Package reviewmanager.v1 is gRPC API of review manager. It mirrors HTTP API of teams,
+// users and pull requests, errors are reported with status codes and ErrorInfo with
+// the same codes as HTTP API, e.g. PR_MERGED.
+
+package reviewmanagerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PullRequestStatus int32
+
+const (
+	PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED PullRequestStatus = 0
+	PullRequestStatus_PULL_REQUEST_STATUS_OPEN        PullRequestStatus = 1
+	PullRequestStatus_PULL_REQUEST_STATUS_MERGED      PullRequestStatus = 2
+)
+
+// Enum value maps for PullRequestStatus.
+var (
+	PullRequestStatus_name = map[int32]string{
+		0: "PULL_REQUEST_STATUS_UNSPECIFIED",
+		1: "PULL_REQUEST_STATUS_OPEN",
+		2: "PULL_REQUEST_STATUS_MERGED",
+	}
+	PullRequestStatus_value = map[string]int32{
+		"PULL_REQUEST_STATUS_UNSPECIFIED": 0,
+		"PULL_REQUEST_STATUS_OPEN":        1,
+		"PULL_REQUEST_STATUS_MERGED":      2,
+	}
+)
+
+func (x PullRequestStatus) Enum() *PullRequestStatus {
+	p := new(PullRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewmanager_v1_review_manager_proto_enumTypes[0].Descriptor()
+}
+
+func (PullRequestStatus) Type() protoreflect.EnumType {
+	return &file_reviewmanager_v1_review_manager_proto_enumTypes[0]
+}
+
+func (x PullRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus.Descriptor instead.
+func (PullRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{0}
+}
+
+type ReviewAction int32
+
+const (
+	ReviewAction_REVIEW_ACTION_UNSPECIFIED ReviewAction = 0
+	ReviewAction_REVIEW_ACTION_KEPT        ReviewAction = 1
+	ReviewAction_REVIEW_ACTION_REASSIGNED  ReviewAction = 2
+	ReviewAction_REVIEW_ACTION_REMOVED     ReviewAction = 3
+)
+
+// Enum value maps for ReviewAction.
+var (
+	ReviewAction_name = map[int32]string{
+		0: "REVIEW_ACTION_UNSPECIFIED",
+		1: "REVIEW_ACTION_KEPT",
+		2: "REVIEW_ACTION_REASSIGNED",
+		3: "REVIEW_ACTION_REMOVED",
+	}
+	ReviewAction_value = map[string]int32{
+		"REVIEW_ACTION_UNSPECIFIED": 0,
+		"REVIEW_ACTION_KEPT":        1,
+		"REVIEW_ACTION_REASSIGNED":  2,
+		"REVIEW_ACTION_REMOVED":     3,
+	}
+)
+
+func (x ReviewAction) Enum() *ReviewAction {
+	p := new(ReviewAction)
+	*p = x
+	return p
+}
+
+func (x ReviewAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewmanager_v1_review_manager_proto_enumTypes[1].Descriptor()
+}
+
+func (ReviewAction) Type() protoreflect.EnumType {
+	return &file_reviewmanager_v1_review_manager_proto_enumTypes[1]
+}
+
+func (x ReviewAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewAction.Descriptor instead.
+func (ReviewAction) EnumDescriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{1}
+}
+
+type AssignmentType int32
+
+const (
+	AssignmentType_ASSIGNMENT_TYPE_UNSPECIFIED AssignmentType = 0
+	// Reviewer was assigned to new pull request.
+	AssignmentType_ASSIGNMENT_TYPE_ASSIGNED AssignmentType = 1
+	// Reviewer replaced previous_reviewer_id.
+	AssignmentType_ASSIGNMENT_TYPE_REASSIGNED AssignmentType = 2
+)
+
+// Enum value maps for AssignmentType.
+var (
+	AssignmentType_name = map[int32]string{
+		0: "ASSIGNMENT_TYPE_UNSPECIFIED",
+		1: "ASSIGNMENT_TYPE_ASSIGNED",
+		2: "ASSIGNMENT_TYPE_REASSIGNED",
+	}
+	AssignmentType_value = map[string]int32{
+		"ASSIGNMENT_TYPE_UNSPECIFIED": 0,
+		"ASSIGNMENT_TYPE_ASSIGNED":    1,
+		"ASSIGNMENT_TYPE_REASSIGNED":  2,
+	}
+)
+
+func (x AssignmentType) Enum() *AssignmentType {
+	p := new(AssignmentType)
+	*p = x
+	return p
+}
+
+func (x AssignmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewmanager_v1_review_manager_proto_enumTypes[2].Descriptor()
+}
+
+func (AssignmentType) Type() protoreflect.EnumType {
+	return &file_reviewmanager_v1_review_manager_proto_enumTypes[2]
+}
+
+func (x AssignmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentType.Descriptor instead.
+func (AssignmentType) EnumDescriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{2}
+}
+
+type User struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Empty for users whose team was deleted.
+	TeamName      string `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*User                `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Archived      bool                   `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*User {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Team) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=reviewmanager.v1.PullRequestStatus" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+// ReviewChange describes what happened to review of open pull request when reviewer left team.
+type ReviewChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	// Empty unless review was reassigned.
+	NewUserId     string       `protobuf:"bytes,3,opt,name=new_user_id,json=newUserId,proto3" json:"new_user_id,omitempty"`
+	Action        ReviewAction `protobuf:"varint,4,opt,name=action,proto3,enum=reviewmanager.v1.ReviewAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewChange) Reset() {
+	*x = ReviewChange{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewChange) ProtoMessage() {}
+
+func (x *ReviewChange) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewChange.ProtoReflect.Descriptor instead.
+func (*ReviewChange) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReviewChange) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReviewChange) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+func (x *ReviewChange) GetNewUserId() string {
+	if x != nil {
+		return x.NewUserId
+	}
+	return ""
+}
+
+func (x *ReviewChange) GetAction() ReviewAction {
+	if x != nil {
+		return x.Action
+	}
+	return ReviewAction_REVIEW_ACTION_UNSPECIFIED
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type SetUserIsActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveRequest) Reset() {
+	*x = SetUserIsActiveRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveRequest) ProtoMessage() {}
+
+func (x *SetUserIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetUserIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetUserIsActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveResponse) Reset() {
+	*x = SetUserIsActiveResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveResponse) ProtoMessage() {}
+
+func (x *SetUserIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetUserIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type MoveUserTeamRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamName string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Reassign open reviews of user inside former team instead of keeping them.
+	ReassignReviews bool `protobuf:"varint,3,opt,name=reassign_reviews,json=reassignReviews,proto3" json:"reassign_reviews,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveUserTeamRequest) Reset() {
+	*x = MoveUserTeamRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUserTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserTeamRequest) ProtoMessage() {}
+
+func (x *MoveUserTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserTeamRequest.ProtoReflect.Descriptor instead.
+func (*MoveUserTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MoveUserTeamRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveUserTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *MoveUserTeamRequest) GetReassignReviews() bool {
+	if x != nil {
+		return x.ReassignReviews
+	}
+	return false
+}
+
+type MoveUserTeamResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TeamName             string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Users                []*User                `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	AffectedPullRequests []*ReviewChange        `protobuf:"bytes,3,rep,name=affected_pull_requests,json=affectedPullRequests,proto3" json:"affected_pull_requests,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MoveUserTeamResponse) Reset() {
+	*x = MoveUserTeamResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUserTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserTeamResponse) ProtoMessage() {}
+
+func (x *MoveUserTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserTeamResponse.ProtoReflect.Descriptor instead.
+func (*MoveUserTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MoveUserTeamResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *MoveUserTeamResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *MoveUserTeamResponse) GetAffectedPullRequests() []*ReviewChange {
+	if x != nil {
+		return x.AffectedPullRequests
+	}
+	return nil
+}
+
+type GetUserReviewsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Unspecified returns pull requests of any status.
+	Status PullRequestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=reviewmanager.v1.PullRequestStatus" json:"status,omitempty"`
+	// Newest pull requests first.
+	Descending bool `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Page size, zero returns all reviews.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_cursor of previous page.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Fill reviewers and timestamps of pull requests.
+	Details       bool `protobuf:"varint,6,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsRequest) Reset() {
+	*x = GetUserReviewsRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsRequest) ProtoMessage() {}
+
+func (x *GetUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *GetUserReviewsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *GetUserReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserReviewsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetDetails() bool {
+	if x != nil {
+		return x.Details
+	}
+	return false
+}
+
+type GetUserReviewsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests []*PullRequest         `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Empty on last page.
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsResponse) Reset() {
+	*x = GetUserReviewsResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsResponse) ProtoMessage() {}
+
+func (x *GetUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *GetUserReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreatePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type GetPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestResponse) Reset() {
+	*x = GetPullRequestResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestResponse) ProtoMessage() {}
+
+func (x *GetPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+// ListPullRequestsRequest filters pull requests, empty fields are not used for filtering.
+type ListPullRequestsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Status     PullRequestStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=reviewmanager.v1.PullRequestStatus" json:"status,omitempty"`
+	AuthorId   string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	TeamName   string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Substring of pull request name, case insensitive.
+	Name        string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	MergedFrom  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=merged_from,json=mergedFrom,proto3" json:"merged_from,omitempty"`
+	MergedTo    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_to,json=mergedTo,proto3" json:"merged_to,omitempty"`
+	// Page size, zero uses default one.
+	Limit int32 `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_cursor of previous page.
+	Cursor        string `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsRequest) Reset() {
+	*x = ListPullRequestsRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsRequest) ProtoMessage() {}
+
+func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListPullRequestsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListPullRequestsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetMergedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetMergedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPullRequestsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListPullRequestsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Empty on last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Number of pull requests matching filters.
+	Total         int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsResponse) Reset() {
+	*x = ListPullRequestsResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsResponse) ProtoMessage() {}
+
+func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPullRequestsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *ListPullRequestsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListPullRequestsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MergePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ReassignPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignPullRequestRequest) Reset() {
+	*x = ReassignPullRequestRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPullRequestRequest) ProtoMessage() {}
+
+func (x *ReassignPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReassignPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReassignPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignPullRequestRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignPullRequestResponse) Reset() {
+	*x = ReassignPullRequestResponse{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPullRequestResponse) ProtoMessage() {}
+
+func (x *ReassignPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*ReassignPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReassignPullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignPullRequestResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+// WatchAssignmentsRequest filters events, empty fields are not used for filtering.
+type WatchAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId    string                 `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	PullRequestId string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAssignmentsRequest) Reset() {
+	*x = WatchAssignmentsRequest{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAssignmentsRequest) ProtoMessage() {}
+
+func (x *WatchAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WatchAssignmentsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *WatchAssignmentsRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type AssignmentEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          AssignmentType         `protobuf:"varint,1,opt,name=type,proto3,enum=reviewmanager.v1.AssignmentType" json:"type,omitempty"`
+	PullRequestId string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// Set for reassignments.
+	PreviousReviewerId string                 `protobuf:"bytes,4,opt,name=previous_reviewer_id,json=previousReviewerId,proto3" json:"previous_reviewer_id,omitempty"`
+	Time               *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AssignmentEvent) Reset() {
+	*x = AssignmentEvent{}
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentEvent) ProtoMessage() {}
+
+func (x *AssignmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewmanager_v1_review_manager_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentEvent.ProtoReflect.Descriptor instead.
+func (*AssignmentEvent) Descriptor() ([]byte, []int) {
+	return file_reviewmanager_v1_review_manager_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AssignmentEvent) GetType() AssignmentType {
+	if x != nil {
+		return x.Type
+	}
+	return AssignmentType_ASSIGNMENT_TYPE_UNSPECIFIED
+}
+
+func (x *AssignmentEvent) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetPreviousReviewerId() string {
+	if x != nil {
+		return x.PreviousReviewerId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_reviewmanager_v1_review_manager_proto protoreflect.FileDescriptor
+
+const file_reviewmanager_v1_review_manager_proto_rawDesc = "" +
+	"\n" +
+	"%reviewmanager/v1/review_manager.proto\x12\x10reviewmanager.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\"q\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x120\n" +
+	"\amembers\x18\x02 \x03(\v2\x16.reviewmanager.v1.UserR\amembers\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\"\xde\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12;\n" +
+	"\x06status\x18\x04 \x01(\x0e2#.reviewmanager.v1.PullRequestStatusR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xae\x01\n" +
+	"\fReviewChange\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\x12\x1e\n" +
+	"\vnew_user_id\x18\x03 \x01(\tR\tnewUserId\x126\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1e.reviewmanager.v1.ReviewActionR\x06action\"<\n" +
+	"\x0eAddTeamRequest\x12*\n" +
+	"\x04team\x18\x01 \x01(\v2\x16.reviewmanager.v1.TeamR\x04team\"=\n" +
+	"\x0fAddTeamResponse\x12*\n" +
+	"\x04team\x18\x01 \x01(\v2\x16.reviewmanager.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"=\n" +
+	"\x0fGetTeamResponse\x12*\n" +
+	"\x04team\x18\x01 \x01(\v2\x16.reviewmanager.v1.TeamR\x04team\"N\n" +
+	"\x16SetUserIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"E\n" +
+	"\x17SetUserIsActiveResponse\x12*\n" +
+	"\x04user\x18\x01 \x01(\v2\x16.reviewmanager.v1.UserR\x04user\"v\n" +
+	"\x13MoveUserTeamRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12)\n" +
+	"\x10reassign_reviews\x18\x03 \x01(\bR\x0freassignReviews\"\xb7\x01\n" +
+	"\x14MoveUserTeamResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12,\n" +
+	"\x05users\x18\x02 \x03(\v2\x16.reviewmanager.v1.UserR\x05users\x12T\n" +
+	"\x16affected_pull_requests\x18\x03 \x03(\v2\x1e.reviewmanager.v1.ReviewChangeR\x14affectedPullRequests\"\xd5\x01\n" +
+	"\x15GetUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2#.reviewmanager.v1.PullRequestStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x03 \x01(\bR\n" +
+	"descending\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x18\n" +
+	"\adetails\x18\x06 \x01(\bR\adetails\"\x96\x01\n" +
+	"\x16GetUserReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1d.reviewmanager.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x8b\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\"J\n" +
+	"\x19CreatePullRequestResponse\x12-\n" +
+	"\x02pr\x18\x01 \x01(\v2\x1d.reviewmanager.v1.PullRequestR\x02pr\"?\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"G\n" +
+	"\x16GetPullRequestResponse\x12-\n" +
+	"\x02pr\x18\x01 \x01(\v2\x1d.reviewmanager.v1.PullRequestR\x02pr\"\xe3\x03\n" +
+	"\x17ListPullRequestsRequest\x12;\n" +
+	"\x06status\x18\x01 \x01(\x0e2#.reviewmanager.v1.PullRequestStatusR\x06status\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12;\n" +
+	"\vmerged_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mergedFrom\x127\n" +
+	"\tmerged_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedTo\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\v \x01(\tR\x06cursor\"\x95\x01\n" +
+	"\x18ListPullRequestsResponse\x12B\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x1d.reviewmanager.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"I\n" +
+	"\x18MergePullRequestResponse\x12-\n" +
+	"\x02pr\x18\x01 \x01(\v2\x1d.reviewmanager.v1.PullRequestR\x02pr\"d\n" +
+	"\x1aReassignPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"m\n" +
+	"\x1bReassignPullRequestResponse\x12-\n" +
+	"\x02pr\x18\x01 \x01(\v2\x1d.reviewmanager.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"b\n" +
+	"\x17WatchAssignmentsRequest\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\"\xf2\x01\n" +
+	"\x0fAssignmentEvent\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .reviewmanager.v1.AssignmentTypeR\x04type\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x120\n" +
+	"\x14previous_reviewer_id\x18\x04 \x01(\tR\x12previousReviewerId\x12.\n" +
+	"\x04time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04time*v\n" +
+	"\x11PullRequestStatus\x12#\n" +
+	"\x1fPULL_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PULL_REQUEST_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_MERGED\x10\x02*~\n" +
+	"\fReviewAction\x12\x1d\n" +
+	"\x19REVIEW_ACTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REVIEW_ACTION_KEPT\x10\x01\x12\x1c\n" +
+	"\x18REVIEW_ACTION_REASSIGNED\x10\x02\x12\x19\n" +
+	"\x15REVIEW_ACTION_REMOVED\x10\x03*o\n" +
+	"\x0eAssignmentType\x12\x1f\n" +
+	"\x1bASSIGNMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ASSIGNMENT_TYPE_ASSIGNED\x10\x01\x12\x1e\n" +
+	"\x1aASSIGNMENT_TYPE_REASSIGNED\x10\x022\xdc\b\n" +
+	"\rReviewManager\x12N\n" +
+	"\aAddTeam\x12 .reviewmanager.v1.AddTeamRequest\x1a!.reviewmanager.v1.AddTeamResponse\x12N\n" +
+	"\aGetTeam\x12 .reviewmanager.v1.GetTeamRequest\x1a!.reviewmanager.v1.GetTeamResponse\x12f\n" +
+	"\x0fSetUserIsActive\x12(.reviewmanager.v1.SetUserIsActiveRequest\x1a).reviewmanager.v1.SetUserIsActiveResponse\x12]\n" +
+	"\fMoveUserTeam\x12%.reviewmanager.v1.MoveUserTeamRequest\x1a&.reviewmanager.v1.MoveUserTeamResponse\x12c\n" +
+	"\x0eGetUserReviews\x12'.reviewmanager.v1.GetUserReviewsRequest\x1a(.reviewmanager.v1.GetUserReviewsResponse\x12l\n" +
+	"\x11CreatePullRequest\x12*.reviewmanager.v1.CreatePullRequestRequest\x1a+.reviewmanager.v1.CreatePullRequestResponse\x12c\n" +
+	"\x0eGetPullRequest\x12'.reviewmanager.v1.GetPullRequestRequest\x1a(.reviewmanager.v1.GetPullRequestResponse\x12i\n" +
+	"\x10ListPullRequests\x12).reviewmanager.v1.ListPullRequestsRequest\x1a*.reviewmanager.v1.ListPullRequestsResponse\x12i\n" +
+	"\x10MergePullRequest\x12).reviewmanager.v1.MergePullRequestRequest\x1a*.reviewmanager.v1.MergePullRequestResponse\x12r\n" +
+	"\x13ReassignPullRequest\x12,.reviewmanager.v1.ReassignPullRequestRequest\x1a-.reviewmanager.v1.ReassignPullRequestResponse\x12b\n" +
+	"\x10WatchAssignments\x12).reviewmanager.v1.WatchAssignmentsRequest\x1a!.reviewmanager.v1.AssignmentEvent0\x01BIZGgithub.com/LeonovDS/review-manager/api/reviewmanager/v1;reviewmanagerv1b\x06proto3"
+
+var (
+	file_reviewmanager_v1_review_manager_proto_rawDescOnce sync.Once
+	file_reviewmanager_v1_review_manager_proto_rawDescData []byte
+)
+
+func file_reviewmanager_v1_review_manager_proto_rawDescGZIP() []byte {
+	file_reviewmanager_v1_review_manager_proto_rawDescOnce.Do(func() {
+		file_reviewmanager_v1_review_manager_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewmanager_v1_review_manager_proto_rawDesc), len(file_reviewmanager_v1_review_manager_proto_rawDesc)))
+	})
+	return file_reviewmanager_v1_review_manager_proto_rawDescData
+}
+
+var file_reviewmanager_v1_review_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_reviewmanager_v1_review_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_reviewmanager_v1_review_manager_proto_goTypes = []any{
+	(PullRequestStatus)(0),              // 0: reviewmanager.v1.PullRequestStatus
+	(ReviewAction)(0),                   // 1: reviewmanager.v1.ReviewAction
+	(AssignmentType)(0),                 // 2: reviewmanager.v1.AssignmentType
+	(*User)(nil),                        // 3: reviewmanager.v1.User
+	(*Team)(nil),                        // 4: reviewmanager.v1.Team
+	(*PullRequest)(nil),                 // 5: reviewmanager.v1.PullRequest
+	(*ReviewChange)(nil),                // 6: reviewmanager.v1.ReviewChange
+	(*AddTeamRequest)(nil),              // 7: reviewmanager.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),             // 8: reviewmanager.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),              // 9: reviewmanager.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),             // 10: reviewmanager.v1.GetTeamResponse
+	(*SetUserIsActiveRequest)(nil),      // 11: reviewmanager.v1.SetUserIsActiveRequest
+	(*SetUserIsActiveResponse)(nil),     // 12: reviewmanager.v1.SetUserIsActiveResponse
+	(*MoveUserTeamRequest)(nil),         // 13: reviewmanager.v1.MoveUserTeamRequest
+	(*MoveUserTeamResponse)(nil),        // 14: reviewmanager.v1.MoveUserTeamResponse
+	(*GetUserReviewsRequest)(nil),       // 15: reviewmanager.v1.GetUserReviewsRequest
+	(*GetUserReviewsResponse)(nil),      // 16: reviewmanager.v1.GetUserReviewsResponse
+	(*CreatePullRequestRequest)(nil),    // 17: reviewmanager.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil),   // 18: reviewmanager.v1.CreatePullRequestResponse
+	(*GetPullRequestRequest)(nil),       // 19: reviewmanager.v1.GetPullRequestRequest
+	(*GetPullRequestResponse)(nil),      // 20: reviewmanager.v1.GetPullRequestResponse
+	(*ListPullRequestsRequest)(nil),     // 21: reviewmanager.v1.ListPullRequestsRequest
+	(*ListPullRequestsResponse)(nil),    // 22: reviewmanager.v1.ListPullRequestsResponse
+	(*MergePullRequestRequest)(nil),     // 23: reviewmanager.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),    // 24: reviewmanager.v1.MergePullRequestResponse
+	(*ReassignPullRequestRequest)(nil),  // 25: reviewmanager.v1.ReassignPullRequestRequest
+	(*ReassignPullRequestResponse)(nil), // 26: reviewmanager.v1.ReassignPullRequestResponse
+	(*WatchAssignmentsRequest)(nil),     // 27: reviewmanager.v1.WatchAssignmentsRequest
+	(*AssignmentEvent)(nil),             // 28: reviewmanager.v1.AssignmentEvent
+	(*timestamppb.Timestamp)(nil),       // 29: google.protobuf.Timestamp
+}
+var file_reviewmanager_v1_review_manager_proto_depIdxs = []int32{
+	3,  // 0: reviewmanager.v1.Team.members:type_name -> reviewmanager.v1.User
+	0,  // 1: reviewmanager.v1.PullRequest.status:type_name -> reviewmanager.v1.PullRequestStatus
+	29, // 2: reviewmanager.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	29, // 3: reviewmanager.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: reviewmanager.v1.ReviewChange.action:type_name -> reviewmanager.v1.ReviewAction
+	4,  // 5: reviewmanager.v1.AddTeamRequest.team:type_name -> reviewmanager.v1.Team
+	4,  // 6: reviewmanager.v1.AddTeamResponse.team:type_name -> reviewmanager.v1.Team
+	4,  // 7: reviewmanager.v1.GetTeamResponse.team:type_name -> reviewmanager.v1.Team
+	3,  // 8: reviewmanager.v1.SetUserIsActiveResponse.user:type_name -> reviewmanager.v1.User
+	3,  // 9: reviewmanager.v1.MoveUserTeamResponse.users:type_name -> reviewmanager.v1.User
+	6,  // 10: reviewmanager.v1.MoveUserTeamResponse.affected_pull_requests:type_name -> reviewmanager.v1.ReviewChange
+	0,  // 11: reviewmanager.v1.GetUserReviewsRequest.status:type_name -> reviewmanager.v1.PullRequestStatus
+	5,  // 12: reviewmanager.v1.GetUserReviewsResponse.pull_requests:type_name -> reviewmanager.v1.PullRequest
+	5,  // 13: reviewmanager.v1.CreatePullRequestResponse.pr:type_name -> reviewmanager.v1.PullRequest
+	5,  // 14: reviewmanager.v1.GetPullRequestResponse.pr:type_name -> reviewmanager.v1.PullRequest
+	0,  // 15: reviewmanager.v1.ListPullRequestsRequest.status:type_name -> reviewmanager.v1.PullRequestStatus
+	29, // 16: reviewmanager.v1.ListPullRequestsRequest.created_from:type_name -> google.protobuf.Timestamp
+	29, // 17: reviewmanager.v1.ListPullRequestsRequest.created_to:type_name -> google.protobuf.Timestamp
+	29, // 18: reviewmanager.v1.ListPullRequestsRequest.merged_from:type_name -> google.protobuf.Timestamp
+	29, // 19: reviewmanager.v1.ListPullRequestsRequest.merged_to:type_name -> google.protobuf.Timestamp
+	5,  // 20: reviewmanager.v1.ListPullRequestsResponse.pull_requests:type_name -> reviewmanager.v1.PullRequest
+	5,  // 21: reviewmanager.v1.MergePullRequestResponse.pr:type_name -> reviewmanager.v1.PullRequest
+	5,  // 22: reviewmanager.v1.ReassignPullRequestResponse.pr:type_name -> reviewmanager.v1.PullRequest
+	2,  // 23: reviewmanager.v1.AssignmentEvent.type:type_name -> reviewmanager.v1.AssignmentType
+	29, // 24: reviewmanager.v1.AssignmentEvent.time:type_name -> google.protobuf.Timestamp
+	7,  // 25: reviewmanager.v1.ReviewManager.AddTeam:input_type -> reviewmanager.v1.AddTeamRequest
+	9,  // 26: reviewmanager.v1.ReviewManager.GetTeam:input_type -> reviewmanager.v1.GetTeamRequest
+	11, // 27: reviewmanager.v1.ReviewManager.SetUserIsActive:input_type -> reviewmanager.v1.SetUserIsActiveRequest
+	13, // 28: reviewmanager.v1.ReviewManager.MoveUserTeam:input_type -> reviewmanager.v1.MoveUserTeamRequest
+	15, // 29: reviewmanager.v1.ReviewManager.GetUserReviews:input_type -> reviewmanager.v1.GetUserReviewsRequest
+	17, // 30: reviewmanager.v1.ReviewManager.CreatePullRequest:input_type -> reviewmanager.v1.CreatePullRequestRequest
+	19, // 31: reviewmanager.v1.ReviewManager.GetPullRequest:input_type -> reviewmanager.v1.GetPullRequestRequest
+	21, // 32: reviewmanager.v1.ReviewManager.ListPullRequests:input_type -> reviewmanager.v1.ListPullRequestsRequest
+	23, // 33: reviewmanager.v1.ReviewManager.MergePullRequest:input_type -> reviewmanager.v1.MergePullRequestRequest
+	25, // 34: reviewmanager.v1.ReviewManager.ReassignPullRequest:input_type -> reviewmanager.v1.ReassignPullRequestRequest
+	27, // 35: reviewmanager.v1.ReviewManager.WatchAssignments:input_type -> reviewmanager.v1.WatchAssignmentsRequest
+	8,  // 36: reviewmanager.v1.ReviewManager.AddTeam:output_type -> reviewmanager.v1.AddTeamResponse
+	10, // 37: reviewmanager.v1.ReviewManager.GetTeam:output_type -> reviewmanager.v1.GetTeamResponse
+	12, // 38: reviewmanager.v1.ReviewManager.SetUserIsActive:output_type -> reviewmanager.v1.SetUserIsActiveResponse
+	14, // 39: reviewmanager.v1.ReviewManager.MoveUserTeam:output_type -> reviewmanager.v1.MoveUserTeamResponse
+	16, // 40: reviewmanager.v1.ReviewManager.GetUserReviews:output_type -> reviewmanager.v1.GetUserReviewsResponse
+	18, // 41: reviewmanager.v1.ReviewManager.CreatePullRequest:output_type -> reviewmanager.v1.CreatePullRequestResponse
+	20, // 42: reviewmanager.v1.ReviewManager.GetPullRequest:output_type -> reviewmanager.v1.GetPullRequestResponse
+	22, // 43: reviewmanager.v1.ReviewManager.ListPullRequests:output_type -> reviewmanager.v1.ListPullRequestsResponse
+	24, // 44: reviewmanager.v1.ReviewManager.MergePullRequest:output_type -> reviewmanager.v1.MergePullRequestResponse
+	26, // 45: reviewmanager.v1.ReviewManager.ReassignPullRequest:output_type -> reviewmanager.v1.ReassignPullRequestResponse
+	28, // 46: reviewmanager.v1.ReviewManager.WatchAssignments:output_type -> reviewmanager.v1.AssignmentEvent
+	36, // [36:47] is the sub-list for method output_type
+	25, // [25:36] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_reviewmanager_v1_review_manager_proto_init() }
+func file_reviewmanager_v1_review_manager_proto_init() {
+	if File_reviewmanager_v1_review_manager_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewmanager_v1_review_manager_proto_rawDesc), len(file_reviewmanager_v1_review_manager_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reviewmanager_v1_review_manager_proto_goTypes,
+		DependencyIndexes: file_reviewmanager_v1_review_manager_proto_depIdxs,
+		EnumInfos:         file_reviewmanager_v1_review_manager_proto_enumTypes,
+		MessageInfos:      file_reviewmanager_v1_review_manager_proto_msgTypes,
+	}.Build()
+	File_reviewmanager_v1_review_manager_proto = out.File
+	file_reviewmanager_v1_review_manager_proto_goTypes = nil
+	file_reviewmanager_v1_review_manager_proto_depIdxs = nil
+}
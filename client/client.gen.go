@@ -0,0 +1,6487 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.8.0 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for APIKeyScope.
+const (
+	APIKeyScopeAdminTeam APIKeyScope = "admin:team"
+	APIKeyScopeRead      APIKeyScope = "read"
+	APIKeyScopeWritePr   APIKeyScope = "write:pr"
+)
+
+// Valid indicates whether the value is a known member of the APIKeyScope enum.
+func (e APIKeyScope) Valid() bool {
+	switch e {
+	case APIKeyScopeAdminTeam:
+		return true
+	case APIKeyScopeRead:
+		return true
+	case APIKeyScopeWritePr:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AssignmentEventType.
+const (
+	AssignmentEventTypeASSIGNED   AssignmentEventType = "ASSIGNED"
+	AssignmentEventTypeMERGED     AssignmentEventType = "MERGED"
+	AssignmentEventTypeREASSIGNED AssignmentEventType = "REASSIGNED"
+)
+
+// Valid indicates whether the value is a known member of the AssignmentEventType enum.
+func (e AssignmentEventType) Valid() bool {
+	switch e {
+	case AssignmentEventTypeASSIGNED:
+		return true
+	case AssignmentEventTypeMERGED:
+		return true
+	case AssignmentEventTypeREASSIGNED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	ErrorResponseErrorCodeBADREQUEST           ErrorResponseErrorCode = "BAD_REQUEST"
+	ErrorResponseErrorCodeFORBIDDEN            ErrorResponseErrorCode = "FORBIDDEN"
+	ErrorResponseErrorCodeIDEMPOTENCYKEYREUSED ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	ErrorResponseErrorCodeINTERNALERROR        ErrorResponseErrorCode = "INTERNAL_ERROR"
+	ErrorResponseErrorCodeNOCANDIDATE          ErrorResponseErrorCode = "NO_CANDIDATE"
+	ErrorResponseErrorCodeNOTASSIGNED          ErrorResponseErrorCode = "NOT_ASSIGNED"
+	ErrorResponseErrorCodeNOTFOUND             ErrorResponseErrorCode = "NOT_FOUND"
+	ErrorResponseErrorCodeOVERLOADED           ErrorResponseErrorCode = "OVERLOADED"
+	ErrorResponseErrorCodePAYLOADTOOLARGE      ErrorResponseErrorCode = "PAYLOAD_TOO_LARGE"
+	ErrorResponseErrorCodePREXISTS             ErrorResponseErrorCode = "PR_EXISTS"
+	ErrorResponseErrorCodePRMERGED             ErrorResponseErrorCode = "PR_MERGED"
+	ErrorResponseErrorCodeREQUESTINPROGRESS    ErrorResponseErrorCode = "REQUEST_IN_PROGRESS"
+	ErrorResponseErrorCodeTEAMARCHIVED         ErrorResponseErrorCode = "TEAM_ARCHIVED"
+	ErrorResponseErrorCodeTEAMEXISTS           ErrorResponseErrorCode = "TEAM_EXISTS"
+	ErrorResponseErrorCodeTEAMHASOPENPRS       ErrorResponseErrorCode = "TEAM_HAS_OPEN_PRS"
+	ErrorResponseErrorCodeTOOMANYREQUESTS      ErrorResponseErrorCode = "TOO_MANY_REQUESTS"
+	ErrorResponseErrorCodeUNAUTHORIZED         ErrorResponseErrorCode = "UNAUTHORIZED"
+	ErrorResponseErrorCodeUNSUPPORTEDMEDIATYPE ErrorResponseErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrorResponseErrorCodeUSERINOTHERTEAM      ErrorResponseErrorCode = "USER_IN_OTHER_TEAM"
+)
+
+// Valid indicates whether the value is a known member of the ErrorResponseErrorCode enum.
+func (e ErrorResponseErrorCode) Valid() bool {
+	switch e {
+	case ErrorResponseErrorCodeBADREQUEST:
+		return true
+	case ErrorResponseErrorCodeFORBIDDEN:
+		return true
+	case ErrorResponseErrorCodeIDEMPOTENCYKEYREUSED:
+		return true
+	case ErrorResponseErrorCodeINTERNALERROR:
+		return true
+	case ErrorResponseErrorCodeNOCANDIDATE:
+		return true
+	case ErrorResponseErrorCodeNOTASSIGNED:
+		return true
+	case ErrorResponseErrorCodeNOTFOUND:
+		return true
+	case ErrorResponseErrorCodeOVERLOADED:
+		return true
+	case ErrorResponseErrorCodePAYLOADTOOLARGE:
+		return true
+	case ErrorResponseErrorCodePREXISTS:
+		return true
+	case ErrorResponseErrorCodePRMERGED:
+		return true
+	case ErrorResponseErrorCodeREQUESTINPROGRESS:
+		return true
+	case ErrorResponseErrorCodeTEAMARCHIVED:
+		return true
+	case ErrorResponseErrorCodeTEAMEXISTS:
+		return true
+	case ErrorResponseErrorCodeTEAMHASOPENPRS:
+		return true
+	case ErrorResponseErrorCodeTOOMANYREQUESTS:
+		return true
+	case ErrorResponseErrorCodeUNAUTHORIZED:
+		return true
+	case ErrorResponseErrorCodeUNSUPPORTEDMEDIATYPE:
+		return true
+	case ErrorResponseErrorCodeUSERINOTHERTEAM:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for HealthReportChecksStatus.
+const (
+	HealthReportChecksStatusFail HealthReportChecksStatus = "fail"
+	HealthReportChecksStatusOk   HealthReportChecksStatus = "ok"
+)
+
+// Valid indicates whether the value is a known member of the HealthReportChecksStatus enum.
+func (e HealthReportChecksStatus) Valid() bool {
+	switch e {
+	case HealthReportChecksStatusFail:
+		return true
+	case HealthReportChecksStatusOk:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusFail HealthReportStatus = "fail"
+	HealthReportStatusOk   HealthReportStatus = "ok"
+)
+
+// Valid indicates whether the value is a known member of the HealthReportStatus enum.
+func (e HealthReportStatus) Valid() bool {
+	switch e {
+	case HealthReportStatusFail:
+		return true
+	case HealthReportStatusOk:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestStatus enum.
+func (e PullRequestStatus) Valid() bool {
+	switch e {
+	case PullRequestStatusMERGED:
+		return true
+	case PullRequestStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestShortStatus enum.
+func (e PullRequestShortStatus) Valid() bool {
+	switch e {
+	case PullRequestShortStatusMERGED:
+		return true
+	case PullRequestShortStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ReviewChangeAction.
+const (
+	ReviewChangeActionKEPT       ReviewChangeAction = "KEPT"
+	ReviewChangeActionREASSIGNED ReviewChangeAction = "REASSIGNED"
+	ReviewChangeActionREMOVED    ReviewChangeAction = "REMOVED"
+)
+
+// Valid indicates whether the value is a known member of the ReviewChangeAction enum.
+func (e ReviewChangeAction) Valid() bool {
+	switch e {
+	case ReviewChangeActionKEPT:
+		return true
+	case ReviewChangeActionREASSIGNED:
+		return true
+	case ReviewChangeActionREMOVED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SyncActionAction.
+const (
+	SyncActionActionCREATETEAM     SyncActionAction = "CREATE_TEAM"
+	SyncActionActionCREATEUSER     SyncActionAction = "CREATE_USER"
+	SyncActionActionDEACTIVATEUSER SyncActionAction = "DEACTIVATE_USER"
+	SyncActionActionMOVEUSER       SyncActionAction = "MOVE_USER"
+	SyncActionActionUPDATEUSER     SyncActionAction = "UPDATE_USER"
+)
+
+// Valid indicates whether the value is a known member of the SyncActionAction enum.
+func (e SyncActionAction) Valid() bool {
+	switch e {
+	case SyncActionActionCREATETEAM:
+		return true
+	case SyncActionActionCREATEUSER:
+		return true
+	case SyncActionActionDEACTIVATEUSER:
+		return true
+	case SyncActionActionMOVEUSER:
+		return true
+	case SyncActionActionUPDATEUSER:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListPullRequestsParamsStatus.
+const (
+	ListPullRequestsParamsStatusMERGED ListPullRequestsParamsStatus = "MERGED"
+	ListPullRequestsParamsStatusOPEN   ListPullRequestsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the ListPullRequestsParamsStatus enum.
+func (e ListPullRequestsParamsStatus) Valid() bool {
+	switch e {
+	case ListPullRequestsParamsStatusMERGED:
+		return true
+	case ListPullRequestsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUserReviewsParamsStatus.
+const (
+	GetUserReviewsParamsStatusMERGED GetUserReviewsParamsStatus = "MERGED"
+	GetUserReviewsParamsStatusOPEN   GetUserReviewsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetUserReviewsParamsStatus enum.
+func (e GetUserReviewsParamsStatus) Valid() bool {
+	switch e {
+	case GetUserReviewsParamsStatusMERGED:
+		return true
+	case GetUserReviewsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUserReviewsParamsOrder.
+const (
+	GetUserReviewsParamsOrderAsc  GetUserReviewsParamsOrder = "asc"
+	GetUserReviewsParamsOrderDesc GetUserReviewsParamsOrder = "desc"
+)
+
+// Valid indicates whether the value is a known member of the GetUserReviewsParamsOrder enum.
+func (e GetUserReviewsParamsOrder) Valid() bool {
+	switch e {
+	case GetUserReviewsParamsOrderAsc:
+		return true
+	case GetUserReviewsParamsOrderDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUserReviewsParamsInclude.
+const (
+	GetUserReviewsParamsIncludeDetails GetUserReviewsParamsInclude = "details"
+)
+
+// Valid indicates whether the value is a known member of the GetUserReviewsParamsInclude enum.
+func (e GetUserReviewsParamsInclude) Valid() bool {
+	switch e {
+	case GetUserReviewsParamsIncludeDetails:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey defines model for ApiKey.
+type APIKey struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// KeyID Example: 3f9a1c2b7d4e5f60
+	KeyID string `json:"key_id"`
+
+	// Name Кому выдан ключ
+	//
+	// Example: ci
+	Name string `json:"name"`
+
+	// OrgID Организация, с данными которой работает ключ
+	//
+	// Example: default
+	OrgID string `json:"org_id"`
+
+	// RevokedAt Время отзыва, отсутствует у действующего ключа
+	RevokedAt *time.Time    `json:"revoked_at,omitempty"`
+	Scopes    []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyScope defines model for ApiKeyScope.
+type APIKeyScope string
+
+// ArchivedTeam defines model for ArchivedTeam.
+type ArchivedTeam struct {
+	// AffectedPullRequests Открытые ревью, с которых сняты участники команды
+	AffectedPullRequests []ReviewChange `json:"affected_pull_requests"`
+	Archived             *bool          `json:"archived,omitempty"`
+	Members              []TeamMember   `json:"members"`
+	TeamName             string         `json:"team_name"`
+}
+
+// AssignmentEvent defines model for AssignmentEvent.
+type AssignmentEvent struct {
+	AuthorID string `json:"author_id"`
+
+	// PreviousReviewerID Заменённый ревьювер, только у REASSIGNED
+	PreviousReviewerID *string `json:"previous_reviewer_id,omitempty"`
+	PullRequestID      string  `json:"pull_request_id"`
+
+	// ReviewerID Назначенный ревьювер, у MERGED — ревьювер слитого PR (событие на каждого)
+	ReviewerID *string `json:"reviewer_id,omitempty"`
+
+	// TeamName Команда ревьюверов PR
+	TeamName string    `json:"team_name"`
+	Time     time.Time `json:"time"`
+
+	// Type `ASSIGNED` — ревьювер назначен на новый PR, `REASSIGNED` — ревьювер заменён, `MERGED` — PR слит
+	Type AssignmentEventType `json:"type"`
+}
+
+// AssignmentEventType `ASSIGNED` — ревьювер назначен на новый PR, `REASSIGNED` — ревьювер заменён, `MERGED` — PR слит
+type AssignmentEventType string
+
+// ErrorResponse Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Ошибки отдельных полей запроса, заполняется для BAD_REQUEST
+		Fields  *[]FieldError `json:"fields,omitempty"`
+		Message string        `json:"message"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю, элементы массивов нумеруются с нуля
+	//
+	// Example: members.1.user_id
+	Field string `json:"field"`
+
+	// Message Example: duplicate user_id
+	Message string `json:"message"`
+}
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	Checks []struct {
+		Error  *string                  `json:"error,omitempty"`
+		Name   string                   `json:"name"`
+		Status HealthReportChecksStatus `json:"status"`
+	} `json:"checks"`
+	Status HealthReportStatus `json:"status"`
+}
+
+// HealthReportChecksStatus defines model for HealthReport.Checks.Status.
+type HealthReportChecksStatus string
+
+// HealthReportStatus defines model for HealthReport.Status.
+type HealthReportStatus string
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	CreatedTeams []string `json:"created_teams"`
+
+	// Errors Ошибки по строкам файла; при наличии ошибок ничего не импортируется
+	Errors *[]struct {
+		// Code `BAD_REQUEST` — неверное значение, `USER_IN_OTHER_TEAM` — пользователь состоит в другой команде, `TEAM_ARCHIVED` — команда архивирована
+		Code    *string `json:"code,omitempty"`
+		Message string  `json:"message"`
+		Row     int     `json:"row"`
+	} `json:"errors,omitempty"`
+	Imported int `json:"imported"`
+}
+
+// MembershipChange defines model for MembershipChange.
+type MembershipChange struct {
+	AffectedPullRequests []ReviewChange `json:"affected_pull_requests"`
+	TeamName             string         `json:"team_name"`
+	Users                []User         `json:"users"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+	AuthorID          string            `json:"author_id"`
+	CreatedAt         *time.Time        `json:"createdAt,omitempty"`
+	MergedAt          *time.Time        `json:"mergedAt,omitempty"`
+	PullRequestID     string            `json:"pull_request_id"`
+	PullRequestName   string            `json:"pull_request_name"`
+	Status            PullRequestStatus `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestPage defines model for PullRequestPage.
+type PullRequestPage struct {
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor   *string       `json:"next_cursor,omitempty"`
+	PullRequests []PullRequest `json:"pull_requests"`
+
+	// Total Общее количество PR, подходящих под фильтры
+	Total int `json:"total"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	// AssignedReviewers Только при include=details
+	AssignedReviewers *[]string `json:"assigned_reviewers,omitempty"`
+	AuthorID          string    `json:"author_id"`
+
+	// CreatedAt Только при include=details
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// MergedAt Только при include=details
+	MergedAt        *time.Time             `json:"mergedAt,omitempty"`
+	PullRequestID   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// ReviewChange defines model for ReviewChange.
+type ReviewChange struct {
+	Action ReviewChangeAction `json:"action"`
+
+	// NewUserID Новый ревьювер, только для REASSIGNED
+	NewUserID     *string `json:"new_user_id,omitempty"`
+	OldUserID     string  `json:"old_user_id"`
+	PullRequestID string  `json:"pull_request_id"`
+}
+
+// ReviewChangeAction defines model for ReviewChange.Action.
+type ReviewChangeAction string
+
+// Roster defines model for Roster.
+type Roster struct {
+	Teams []Team `json:"teams"`
+}
+
+// SyncAction defines model for SyncAction.
+type SyncAction struct {
+	Action SyncActionAction `json:"action"`
+
+	// FromTeam Прежняя команда, только для MOVE_USER
+	FromTeam *string `json:"from_team,omitempty"`
+	TeamName string  `json:"team_name"`
+	UserID   *string `json:"user_id,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// SyncActionAction defines model for SyncAction.Action.
+type SyncActionAction string
+
+// SyncPlan defines model for SyncPlan.
+type SyncPlan struct {
+	Actions []SyncAction `json:"actions"`
+
+	// AffectedPullRequests Переназначенные ревью деактивированных пользователей (пусто при dry_run)
+	AffectedPullRequests []ReviewChange `json:"affected_pull_requests"`
+	DryRun               bool           `json:"dry_run"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// Archived Присутствует только у архивных команд
+	Archived *bool        `json:"archived,omitempty"`
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool `json:"is_active"`
+
+	// TeamName Отсутствует у пользователей без команды
+	TeamName *string `json:"team_name,omitempty"`
+	UserID   string  `json:"user_id"`
+	Username string  `json:"username"`
+}
+
+// PullRequestIDQuery defines model for PullRequestIdQuery.
+type PullRequestIDQuery = string
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIDQuery defines model for UserIdQuery.
+type UserIDQuery = string
+
+// Error Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type Error = ErrorResponse
+
+// CreateAPIKeyJSONBody defines parameters for CreateAPIKey.
+type CreateAPIKeyJSONBody struct {
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+}
+
+// RevokeAPIKeyJSONBody defines parameters for RevokeAPIKey.
+type RevokeAPIKeyJSONBody struct {
+	KeyID string `json:"key_id"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// UserID Автор, ревьювер или заменённый ревьювер
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Команда ревьюверов
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// ImportUsers400JSONResponseBody defines parameters for ImportUsers.
+type ImportUsers400JSONResponseBody struct {
+	union json.RawMessage
+}
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	AuthorID        string `json:"author_id"`
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// GetPullRequestParams defines parameters for GetPullRequest.
+type GetPullRequestParams struct {
+	// PullRequestID Идентификатор PR
+	PullRequestID PullRequestIDQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// IfNoneMatch ETag из предыдущего ответа
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// ListPullRequestsParams defines parameters for ListPullRequests.
+type ListPullRequestsParams struct {
+	Status     *ListPullRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorID   *string                       `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerID *string                       `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Name Подстрока названия PR
+	Name        *string    `form:"name,omitempty" json:"name,omitempty"`
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom  *time.Time `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+	MergedTo    *time.Time `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+	Limit       *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor      *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListPullRequestsParamsStatus defines parameters for ListPullRequests.
+type ListPullRequestsParamsStatus string
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
+	PullRequestID string `json:"pull_request_id"`
+}
+
+// ReassignPullRequestJSONBody defines parameters for ReassignPullRequest.
+type ReassignPullRequestJSONBody struct {
+	OldUserID     string `json:"old_user_id"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
+// ArchiveTeamJSONBody defines parameters for ArchiveTeam.
+type ArchiveTeamJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// DeleteTeamJSONBody defines parameters for DeleteTeam.
+type DeleteTeamJSONBody struct {
+	TargetTeamName *string `json:"target_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// RemoveTeamMembersJSONBody defines parameters for RemoveTeamMembers.
+type RemoveTeamMembersJSONBody struct {
+	// ReassignReviews Переназначить открытые ревью внутри команды
+	ReassignReviews *bool    `json:"reassign_reviews,omitempty"`
+	TeamName        string   `json:"team_name"`
+	UserIds         []string `json:"user_ids"`
+}
+
+// RenameTeamJSONBody defines parameters for RenameTeam.
+type RenameTeamJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// SyncTeamsParams defines parameters for SyncTeams.
+type SyncTeamsParams struct {
+	// DryRun Только показать план изменений
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetUserReviewsParams defines parameters for GetUserReviews.
+type GetUserReviewsParams struct {
+	// UserID Идентификатор пользователя
+	UserID UserIDQuery                 `form:"user_id" json:"user_id"`
+	Status *GetUserReviewsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Order Сортировка по времени создания
+	Order *GetUserReviewsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Без параметра возвращаются все PR
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Include Добавить ревьюверов и временные метки
+	Include *GetUserReviewsParamsInclude `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// GetUserReviewsParamsStatus defines parameters for GetUserReviews.
+type GetUserReviewsParamsStatus string
+
+// GetUserReviewsParamsOrder defines parameters for GetUserReviews.
+type GetUserReviewsParamsOrder string
+
+// GetUserReviewsParamsInclude defines parameters for GetUserReviews.
+type GetUserReviewsParamsInclude string
+
+// MoveUserTeamJSONBody defines parameters for MoveUserTeam.
+type MoveUserTeamJSONBody struct {
+	// ReassignReviews Переназначить открытые ревью на участников прежней команды (если кандидатов нет, ревьювер снимается)
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+	TeamName        string `json:"team_name"`
+	UserID          string `json:"user_id"`
+}
+
+// SetUserIsActiveJSONBody defines parameters for SetUserIsActive.
+type SetUserIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserID   string `json:"user_id"`
+}
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody CreateAPIKeyJSONBody
+
+// RevokeAPIKeyJSONRequestBody defines body for RevokeAPIKey for application/json ContentType.
+type RevokeAPIKeyJSONRequestBody RevokeAPIKeyJSONBody
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignPullRequestJSONRequestBody defines body for ReassignPullRequest for application/json ContentType.
+type ReassignPullRequestJSONRequestBody ReassignPullRequestJSONBody
+
+// AddTeamJSONRequestBody defines body for AddTeam for application/json ContentType.
+type AddTeamJSONRequestBody = Team
+
+// AddTeamMembersJSONRequestBody defines body for AddTeamMembers for application/json ContentType.
+type AddTeamMembersJSONRequestBody = Team
+
+// ArchiveTeamJSONRequestBody defines body for ArchiveTeam for application/json ContentType.
+type ArchiveTeamJSONRequestBody ArchiveTeamJSONBody
+
+// DeleteTeamJSONRequestBody defines body for DeleteTeam for application/json ContentType.
+type DeleteTeamJSONRequestBody DeleteTeamJSONBody
+
+// RemoveTeamMembersJSONRequestBody defines body for RemoveTeamMembers for application/json ContentType.
+type RemoveTeamMembersJSONRequestBody RemoveTeamMembersJSONBody
+
+// RenameTeamJSONRequestBody defines body for RenameTeam for application/json ContentType.
+type RenameTeamJSONRequestBody RenameTeamJSONBody
+
+// SyncTeamsJSONRequestBody defines body for SyncTeams for application/json ContentType.
+type SyncTeamsJSONRequestBody = Roster
+
+// MoveUserTeamJSONRequestBody defines body for MoveUserTeam for application/json ContentType.
+type MoveUserTeamJSONRequestBody MoveUserTeamJSONBody
+
+// SetUserIsActiveJSONRequestBody defines body for SetUserIsActive for application/json ContentType.
+type SetUserIsActiveJSONRequestBody SetUserIsActiveJSONBody
+
+// AsImportResult returns the union data inside the ImportUsers400JSONResponseBody as a ImportResult
+func (t ImportUsers400JSONResponseBody) AsImportResult() (ImportResult, error) {
+	var body ImportResult
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromImportResult overwrites any union data inside the ImportUsers400JSONResponseBody as the provided ImportResult
+func (t *ImportUsers400JSONResponseBody) FromImportResult(v ImportResult) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeImportResult performs a merge with any union data inside the ImportUsers400JSONResponseBody, using the provided ImportResult
+func (t *ImportUsers400JSONResponseBody) MergeImportResult(v ImportResult) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorResponse returns the union data inside the ImportUsers400JSONResponseBody as a ErrorResponse
+func (t ImportUsers400JSONResponseBody) AsErrorResponse() (ErrorResponse, error) {
+	var body ErrorResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorResponse overwrites any union data inside the ImportUsers400JSONResponseBody as the provided ErrorResponse
+func (t *ImportUsers400JSONResponseBody) FromErrorResponse(v ErrorResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorResponse performs a merge with any union data inside the ImportUsers400JSONResponseBody, using the provided ErrorResponse
+func (t *ImportUsers400JSONResponseBody) MergeErrorResponse(v ErrorResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ImportUsers400JSONResponseBody) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ImportUsers400JSONResponseBody) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// RequestEditorFn is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+
+	// CreateAPIKeyWithBody Выпустить API-ключ
+	//
+	// Токен возвращается только в этом ответе, в БД хранится его хеш.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+	CreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAPIKey Выпустить API-ключ
+	//
+	// Токен возвращается только в этом ответе, в БД хранится его хеш.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+	CreateAPIKey(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAPIKeys Список API-ключей, включая отозванные
+	//
+	// Corresponds with GET /apiKey/list (the `ListAPIKeys` operationId).
+	ListAPIKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAPIKeyWithBody Отозвать API-ключ
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+	RevokeAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAPIKey Отозвать API-ключ
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+	RevokeAPIKey(ctx context.Context, body RevokeAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamEvents Поток назначений, переназначений и слияний PR (Server-Sent Events)
+	//
+	// Соединение остаётся открытым, каждое событие приходит сообщением с полем `event`,
+	// равным типу события, и `AssignmentEvent` в JSON в поле `data`. Без фильтров
+	// приходят все события организации. События других реплик сервиса тоже доставляются.
+	// Пропущенные за время разрыва события не повторяются, после переподключения
+	// актуальное состояние стоит перечитать через `/users/getReview`.
+	//
+	// Corresponds with GET /events/stream (the `StreamEvents` operationId).
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportPullRequests Выгрузить PR в CSV (ревьюверы через пробел)
+	//
+	// Corresponds with GET /export/pullRequests (the `ExportPullRequests` operationId).
+	ExportPullRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportUsers Выгрузить пользователей в CSV (формат совпадает с импортом)
+	//
+	// Corresponds with GET /export/users (the `ExportUsers` operationId).
+	ExportUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLiveness Проверка, что процесс жив (liveness)
+	//
+	// Corresponds with GET /health/live (the `GetLiveness` operationId).
+	GetLiveness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReadiness Готовность принимать трафик (readiness)
+	//
+	// Проверяет соединение с БД, совпадение версии схемы с версией,
+	// ожидаемой сервером, и состояние фоновых задач.
+	//
+	// Corresponds with GET /health/ready (the `GetReadiness` operationId).
+	GetReadiness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportUsersWithBody Импортировать пользователей из CSV (создаёт недостающие команды)
+	//
+	// Как и при добавлении участников, пользователи других команд и строки архивированных
+	// команд отклоняются. Открытые ревью пользователей, которые становятся неактивными,
+	// переназначаются внутри их команды.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /import/users (the `ImportUsers` operationId).
+	ImportUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMetrics Метрики в формате Prometheus
+	//
+	// HTTP-запросы по маршрутам, состояние пула соединений с БД,
+	// назначения ревьюверов, `NO_CANDIDATE`, переназначения по командам
+	// и число открытых ревью у каждого пользователя.
+	//
+	// Corresponds with GET /metrics (the `GetMetrics` operationId).
+	GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePullRequestWithBody Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+	CreatePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePullRequest Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+	CreatePullRequest(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPullRequest Получить PR с ревьюверами
+	//
+	// Corresponds with GET /pullRequest/get (the `GetPullRequest` operationId).
+	GetPullRequest(ctx context.Context, params *GetPullRequestParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPullRequests Получить список PR с фильтрами и постраничной выдачей (по времени создания)
+	//
+	// Corresponds with GET /pullRequest/list (the `ListPullRequests` operationId).
+	ListPullRequests(ctx context.Context, params *ListPullRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MergePullRequestWithBody Пометить PR как MERGED (идемпотентная операция)
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+	MergePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MergePullRequest Пометить PR как MERGED (идемпотентная операция)
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+	MergePullRequest(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReassignPullRequestWithBody Переназначить конкретного ревьювера на другого из его команды
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+	ReassignPullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReassignPullRequest Переназначить конкретного ревьювера на другого из его команды
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+	ReassignPullRequest(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTeamWithBody Создать команду с участниками (создаёт/обновляет пользователей)
+	//
+	// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /team/add (the `AddTeam` operationId).
+	AddTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTeam Создать команду с участниками (создаёт/обновляет пользователей)
+	//
+	// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /team/add (the `AddTeam` operationId).
+	AddTeam(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTeamMembersWithBody Добавить участников в существующую команду
+	//
+	// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+	AddTeamMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTeamMembers Добавить участников в существующую команду
+	//
+	// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+	AddTeamMembers(ctx context.Context, body AddTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveTeamWithBody Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+	//
+	// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+	ArchiveTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveTeam Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+	//
+	// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+	ArchiveTeam(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTeamWithBody Удалить команду
+	//
+	// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+	DeleteTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTeam Удалить команду
+	//
+	// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+	DeleteTeam(ctx context.Context, body DeleteTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeam Получить команду с участниками
+	//
+	// Corresponds with GET /team/get (the `GetTeam` operationId).
+	GetTeam(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveTeamMembersWithBody Исключить участников из команды (пользователи деактивируются)
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+	RemoveTeamMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveTeamMembers Исключить участников из команды (пользователи деактивируются)
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+	RemoveTeamMembers(ctx context.Context, body RemoveTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenameTeamWithBody Переименовать команду (участники переходят под новое имя)
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+	RenameTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenameTeam Переименовать команду (участники переходят под новое имя)
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+	RenameTeam(ctx context.Context, body RenameTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncTeamsWithBody Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+	//
+	// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+	SyncTeamsWithBody(ctx context.Context, params *SyncTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncTeams Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+	//
+	// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+	SyncTeams(ctx context.Context, params *SyncTeamsParams, body SyncTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserReviews Получить PR'ы, где пользователь назначен ревьювером
+	//
+	// Corresponds with GET /users/getReview (the `GetUserReviews` operationId).
+	GetUserReviews(ctx context.Context, params *GetUserReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MoveUserTeamWithBody Перевести пользователя в другую команду
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+	MoveUserTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MoveUserTeam Перевести пользователя в другую команду
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+	MoveUserTeam(ctx context.Context, body MoveUserTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUserIsActiveWithBody Установить флаг активности пользователя
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+	SetUserIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUserIsActive Установить флаг активности пользователя
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+	SetUserIsActive(ctx context.Context, body SetUserIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+// CreateAPIKeyWithBody Выпустить API-ключ
+//
+// Токен возвращается только в этом ответе, в БД хранится его хеш.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+func (c *Client) CreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateAPIKey Выпустить API-ключ
+//
+// Токен возвращается только в этом ответе, в БД хранится его хеш.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+func (c *Client) CreateAPIKey(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListAPIKeys Список API-ключей, включая отозванные
+//
+// Corresponds with GET /apiKey/list (the `ListAPIKeys` operationId).
+func (c *Client) ListAPIKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAPIKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RevokeAPIKeyWithBody Отозвать API-ключ
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+func (c *Client) RevokeAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAPIKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RevokeAPIKey Отозвать API-ключ
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+func (c *Client) RevokeAPIKey(ctx context.Context, body RevokeAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAPIKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// StreamEvents Поток назначений, переназначений и слияний PR (Server-Sent Events)
+//
+// Соединение остаётся открытым, каждое событие приходит сообщением с полем `event`,
+// равным типу события, и `AssignmentEvent` в JSON в поле `data`. Без фильтров
+// приходят все события организации. События других реплик сервиса тоже доставляются.
+// Пропущенные за время разрыва события не повторяются, после переподключения
+// актуальное состояние стоит перечитать через `/users/getReview`.
+//
+// Corresponds with GET /events/stream (the `StreamEvents` operationId).
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ExportPullRequests Выгрузить PR в CSV (ревьюверы через пробел)
+//
+// Corresponds with GET /export/pullRequests (the `ExportPullRequests` operationId).
+func (c *Client) ExportPullRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPullRequestsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ExportUsers Выгрузить пользователей в CSV (формат совпадает с импортом)
+//
+// Corresponds with GET /export/users (the `ExportUsers` operationId).
+func (c *Client) ExportUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportUsersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetLiveness Проверка, что процесс жив (liveness)
+//
+// Corresponds with GET /health/live (the `GetLiveness` operationId).
+func (c *Client) GetLiveness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLivenessRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetReadiness Готовность принимать трафик (readiness)
+//
+// Проверяет соединение с БД, совпадение версии схемы с версией,
+// ожидаемой сервером, и состояние фоновых задач.
+//
+// Corresponds with GET /health/ready (the `GetReadiness` operationId).
+func (c *Client) GetReadiness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReadinessRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ImportUsersWithBody Импортировать пользователей из CSV (создаёт недостающие команды)
+//
+// Как и при добавлении участников, пользователи других команд и строки архивированных
+// команд отклоняются. Открытые ревью пользователей, которые становятся неактивными,
+// переназначаются внутри их команды.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /import/users (the `ImportUsers` operationId).
+func (c *Client) ImportUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportUsersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetMetrics Метрики в формате Prometheus
+//
+// HTTP-запросы по маршрутам, состояние пула соединений с БД,
+// назначения ревьюверов, `NO_CANDIDATE`, переназначения по командам
+// и число открытых ревью у каждого пользователя.
+//
+// Corresponds with GET /metrics (the `GetMetrics` operationId).
+func (c *Client) GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMetricsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreatePullRequestWithBody Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+func (c *Client) CreatePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreatePullRequest Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+func (c *Client) CreatePullRequest(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetPullRequest Получить PR с ревьюверами
+//
+// Corresponds with GET /pullRequest/get (the `GetPullRequest` operationId).
+func (c *Client) GetPullRequest(ctx context.Context, params *GetPullRequestParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListPullRequests Получить список PR с фильтрами и постраничной выдачей (по времени создания)
+//
+// Corresponds with GET /pullRequest/list (the `ListPullRequests` operationId).
+func (c *Client) ListPullRequests(ctx context.Context, params *ListPullRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPullRequestsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// MergePullRequestWithBody Пометить PR как MERGED (идемпотентная операция)
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+func (c *Client) MergePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// MergePullRequest Пометить PR как MERGED (идемпотентная операция)
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+func (c *Client) MergePullRequest(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ReassignPullRequestWithBody Переназначить конкретного ревьювера на другого из его команды
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+func (c *Client) ReassignPullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignPullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ReassignPullRequest Переназначить конкретного ревьювера на другого из его команды
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+func (c *Client) ReassignPullRequest(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignPullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// AddTeamWithBody Создать команду с участниками (создаёт/обновляет пользователей)
+//
+// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /team/add (the `AddTeam` operationId).
+func (c *Client) AddTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// AddTeam Создать команду с участниками (создаёт/обновляет пользователей)
+//
+// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /team/add (the `AddTeam` operationId).
+func (c *Client) AddTeam(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// AddTeamMembersWithBody Добавить участников в существующую команду
+//
+// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+func (c *Client) AddTeamMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamMembersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// AddTeamMembers Добавить участников в существующую команду
+//
+// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+func (c *Client) AddTeamMembers(ctx context.Context, body AddTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamMembersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ArchiveTeamWithBody Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+//
+// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+func (c *Client) ArchiveTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ArchiveTeam Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+//
+// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+func (c *Client) ArchiveTeam(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteTeamWithBody Удалить команду
+//
+// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+func (c *Client) DeleteTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteTeam Удалить команду
+//
+// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+func (c *Client) DeleteTeam(ctx context.Context, body DeleteTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetTeam Получить команду с участниками
+//
+// Corresponds with GET /team/get (the `GetTeam` operationId).
+func (c *Client) GetTeam(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RemoveTeamMembersWithBody Исключить участников из команды (пользователи деактивируются)
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+func (c *Client) RemoveTeamMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTeamMembersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RemoveTeamMembers Исключить участников из команды (пользователи деактивируются)
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+func (c *Client) RemoveTeamMembers(ctx context.Context, body RemoveTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTeamMembersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RenameTeamWithBody Переименовать команду (участники переходят под новое имя)
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+func (c *Client) RenameTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RenameTeam Переименовать команду (участники переходят под новое имя)
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+func (c *Client) RenameTeam(ctx context.Context, body RenameTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SyncTeamsWithBody Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+//
+// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+func (c *Client) SyncTeamsWithBody(ctx context.Context, params *SyncTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncTeamsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SyncTeams Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+//
+// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+func (c *Client) SyncTeams(ctx context.Context, params *SyncTeamsParams, body SyncTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncTeamsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetUserReviews Получить PR'ы, где пользователь назначен ревьювером
+//
+// Corresponds with GET /users/getReview (the `GetUserReviews` operationId).
+func (c *Client) GetUserReviews(ctx context.Context, params *GetUserReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserReviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// MoveUserTeamWithBody Перевести пользователя в другую команду
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+func (c *Client) MoveUserTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveUserTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// MoveUserTeam Перевести пользователя в другую команду
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+func (c *Client) MoveUserTeam(ctx context.Context, body MoveUserTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveUserTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SetUserIsActiveWithBody Установить флаг активности пользователя
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+func (c *Client) SetUserIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserIsActiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SetUserIsActive Установить флаг активности пользователя
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+func (c *Client) SetUserIsActive(ctx context.Context, body SetUserIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserIsActiveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCreateAPIKeyRequest calls the generic CreateAPIKey builder with application/json body
+func NewCreateAPIKeyRequest(server string, body CreateAPIKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAPIKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAPIKeyRequestWithBody constructs an http.Request for the CreateAPIKey method, with any body, and a specified content type
+func NewCreateAPIKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/apiKey/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAPIKeysRequest constructs an http.Request for the ListAPIKeys method
+func NewListAPIKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/apiKey/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeAPIKeyRequest calls the generic RevokeAPIKey builder with application/json body
+func NewRevokeAPIKeyRequest(server string, body RevokeAPIKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRevokeAPIKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRevokeAPIKeyRequestWithBody constructs an http.Request for the RevokeAPIKey method, with any body, and a specified content type
+func NewRevokeAPIKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/apiKey/revoke")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStreamEventsRequest constructs an http.Request for the StreamEvents method
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.UserID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "user_id", *params.UserID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "team_name", *params.TeamName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportPullRequestsRequest constructs an http.Request for the ExportPullRequests method
+func NewExportPullRequestsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export/pullRequests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportUsersRequest constructs an http.Request for the ExportUsers method
+func NewExportUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLivenessRequest constructs an http.Request for the GetLiveness method
+func NewGetLivenessRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReadinessRequest constructs an http.Request for the GetReadiness method
+func NewGetReadinessRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportUsersRequestWithBody constructs an http.Request for the ImportUsers method, with any body, and a specified content type
+func NewImportUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMetricsRequest constructs an http.Request for the GetMetrics method
+func NewGetMetricsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePullRequestRequest calls the generic CreatePullRequest builder with application/json body
+func NewCreatePullRequestRequest(server string, body CreatePullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePullRequestRequestWithBody constructs an http.Request for the CreatePullRequest method, with any body, and a specified content type
+func NewCreatePullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPullRequestRequest constructs an http.Request for the GetPullRequest method
+func NewGetPullRequestRequest(server string, params *GetPullRequestParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "pull_request_id", params.PullRequestID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "If-None-Match", *params.IfNoneMatch, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListPullRequestsRequest constructs an http.Request for the ListPullRequests method
+func NewListPullRequestsRequest(server string, params *ListPullRequestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.AuthorID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "author_id", *params.AuthorID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ReviewerID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "reviewer_id", *params.ReviewerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "team_name", *params.TeamName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "name", *params.Name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.CreatedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "created_from", *params.CreatedFrom, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.CreatedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "created_to", *params.CreatedTo, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.MergedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "merged_from", *params.MergedFrom, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.MergedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "merged_to", *params.MergedTo, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date-time"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMergePullRequestRequest calls the generic MergePullRequest builder with application/json body
+func NewMergePullRequestRequest(server string, body MergePullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergePullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMergePullRequestRequestWithBody constructs an http.Request for the MergePullRequest method, with any body, and a specified content type
+func NewMergePullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReassignPullRequestRequest calls the generic ReassignPullRequest builder with application/json body
+func NewReassignPullRequestRequest(server string, body ReassignPullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReassignPullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReassignPullRequestRequestWithBody constructs an http.Request for the ReassignPullRequest method, with any body, and a specified content type
+func NewReassignPullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reassign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddTeamRequest calls the generic AddTeam builder with application/json body
+func NewAddTeamRequest(server string, body AddTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddTeamRequestWithBody constructs an http.Request for the AddTeam method, with any body, and a specified content type
+func NewAddTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddTeamMembersRequest calls the generic AddTeamMembers builder with application/json body
+func NewAddTeamMembersRequest(server string, body AddTeamMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTeamMembersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddTeamMembersRequestWithBody constructs an http.Request for the AddTeamMembers method, with any body, and a specified content type
+func NewAddTeamMembersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/addMembers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewArchiveTeamRequest calls the generic ArchiveTeam builder with application/json body
+func NewArchiveTeamRequest(server string, body ArchiveTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewArchiveTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewArchiveTeamRequestWithBody constructs an http.Request for the ArchiveTeam method, with any body, and a specified content type
+func NewArchiveTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/archive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTeamRequest calls the generic DeleteTeam builder with application/json body
+func NewDeleteTeamRequest(server string, body DeleteTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteTeamRequestWithBody constructs an http.Request for the DeleteTeam method, with any body, and a specified content type
+func NewDeleteTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamRequest constructs an http.Request for the GetTeam method
+func NewGetTeamRequest(server string, params *GetTeamParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "team_name", params.TeamName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveTeamMembersRequest calls the generic RemoveTeamMembers builder with application/json body
+func NewRemoveTeamMembersRequest(server string, body RemoveTeamMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveTeamMembersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRemoveTeamMembersRequestWithBody constructs an http.Request for the RemoveTeamMembers method, with any body, and a specified content type
+func NewRemoveTeamMembersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/removeMembers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRenameTeamRequest calls the generic RenameTeam builder with application/json body
+func NewRenameTeamRequest(server string, body RenameTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRenameTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRenameTeamRequestWithBody constructs an http.Request for the RenameTeam method, with any body, and a specified content type
+func NewRenameTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/rename")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSyncTeamsRequest calls the generic SyncTeams builder with application/json body
+func NewSyncTeamsRequest(server string, params *SyncTeamsParams, body SyncTeamsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSyncTeamsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSyncTeamsRequestWithBody constructs an http.Request for the SyncTeams method, with any body, and a specified content type
+func NewSyncTeamsRequestWithBody(server string, params *SyncTeamsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "dry_run", *params.DryRun, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserReviewsRequest constructs an http.Request for the GetUserReviews method
+func NewGetUserReviewsRequest(server string, params *GetUserReviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getReview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "user_id", params.UserID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Include != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include", *params.Include, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMoveUserTeamRequest calls the generic MoveUserTeam builder with application/json body
+func NewMoveUserTeamRequest(server string, body MoveUserTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveUserTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMoveUserTeamRequestWithBody constructs an http.Request for the MoveUserTeam method, with any body, and a specified content type
+func NewMoveUserTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/moveTeam")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetUserIsActiveRequest calls the generic SetUserIsActive builder with application/json body
+func NewSetUserIsActiveRequest(server string, body SetUserIsActiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUserIsActiveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetUserIsActiveRequestWithBody constructs an http.Request for the SetUserIsActive method, with any body, and a specified content type
+func NewSetUserIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setIsActive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+
+	// CreateAPIKeyWithBodyWithResponse Выпустить API-ключ
+	//
+	// Токен возвращается только в этом ответе, в БД хранится его хеш.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+	CreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	// CreateAPIKeyWithResponse Выпустить API-ключ
+	//
+	// Токен возвращается только в этом ответе, в БД хранится его хеш.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+	CreateAPIKeyWithResponse(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	// ListAPIKeysWithResponse Список API-ключей, включая отозванные
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /apiKey/list (the `ListAPIKeys` operationId).
+	ListAPIKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error)
+
+	// RevokeAPIKeyWithBodyWithResponse Отозвать API-ключ
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+	RevokeAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error)
+
+	// RevokeAPIKeyWithResponse Отозвать API-ключ
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+	RevokeAPIKeyWithResponse(ctx context.Context, body RevokeAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error)
+
+	// StreamEventsWithResponse Поток назначений, переназначений и слияний PR (Server-Sent Events)
+	//
+	// Соединение остаётся открытым, каждое событие приходит сообщением с полем `event`,
+	// равным типу события, и `AssignmentEvent` в JSON в поле `data`. Без фильтров
+	// приходят все события организации. События других реплик сервиса тоже доставляются.
+	// Пропущенные за время разрыва события не повторяются, после переподключения
+	// актуальное состояние стоит перечитать через `/users/getReview`.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /events/stream (the `StreamEvents` operationId).
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// ExportPullRequestsWithResponse Выгрузить PR в CSV (ревьюверы через пробел)
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /export/pullRequests (the `ExportPullRequests` operationId).
+	ExportPullRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportPullRequestsResponse, error)
+
+	// ExportUsersWithResponse Выгрузить пользователей в CSV (формат совпадает с импортом)
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /export/users (the `ExportUsers` operationId).
+	ExportUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportUsersResponse, error)
+
+	// GetLivenessWithResponse Проверка, что процесс жив (liveness)
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /health/live (the `GetLiveness` operationId).
+	GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error)
+
+	// GetReadinessWithResponse Готовность принимать трафик (readiness)
+	//
+	// Проверяет соединение с БД, совпадение версии схемы с версией,
+	// ожидаемой сервером, и состояние фоновых задач.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /health/ready (the `GetReadiness` operationId).
+	GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error)
+
+	// ImportUsersWithBodyWithResponse Импортировать пользователей из CSV (создаёт недостающие команды)
+	//
+	// Как и при добавлении участников, пользователи других команд и строки архивированных
+	// команд отклоняются. Открытые ревью пользователей, которые становятся неактивными,
+	// переназначаются внутри их команды.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /import/users (the `ImportUsers` operationId).
+	ImportUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportUsersResponse, error)
+
+	// GetMetricsWithResponse Метрики в формате Prometheus
+	//
+	// HTTP-запросы по маршрутам, состояние пула соединений с БД,
+	// назначения ревьюверов, `NO_CANDIDATE`, переназначения по командам
+	// и число открытых ревью у каждого пользователя.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /metrics (the `GetMetrics` operationId).
+	GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error)
+
+	// CreatePullRequestWithBodyWithResponse Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+	CreatePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error)
+
+	// CreatePullRequestWithResponse Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+	CreatePullRequestWithResponse(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error)
+
+	// GetPullRequestWithResponse Получить PR с ревьюверами
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /pullRequest/get (the `GetPullRequest` operationId).
+	GetPullRequestWithResponse(ctx context.Context, params *GetPullRequestParams, reqEditors ...RequestEditorFn) (*GetPullRequestResponse, error)
+
+	// ListPullRequestsWithResponse Получить список PR с фильтрами и постраничной выдачей (по времени создания)
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /pullRequest/list (the `ListPullRequests` operationId).
+	ListPullRequestsWithResponse(ctx context.Context, params *ListPullRequestsParams, reqEditors ...RequestEditorFn) (*ListPullRequestsResponse, error)
+
+	// MergePullRequestWithBodyWithResponse Пометить PR как MERGED (идемпотентная операция)
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+	MergePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error)
+
+	// MergePullRequestWithResponse Пометить PR как MERGED (идемпотентная операция)
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+	MergePullRequestWithResponse(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error)
+
+	// ReassignPullRequestWithBodyWithResponse Переназначить конкретного ревьювера на другого из его команды
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+	ReassignPullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error)
+
+	// ReassignPullRequestWithResponse Переназначить конкретного ревьювера на другого из его команды
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+	ReassignPullRequestWithResponse(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error)
+
+	// AddTeamWithBodyWithResponse Создать команду с участниками (создаёт/обновляет пользователей)
+	//
+	// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/add (the `AddTeam` operationId).
+	AddTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTeamResponse, error)
+
+	// AddTeamWithResponse Создать команду с участниками (создаёт/обновляет пользователей)
+	//
+	// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/add (the `AddTeam` operationId).
+	AddTeamWithResponse(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTeamResponse, error)
+
+	// AddTeamMembersWithBodyWithResponse Добавить участников в существующую команду
+	//
+	// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+	AddTeamMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTeamMembersResponse, error)
+
+	// AddTeamMembersWithResponse Добавить участников в существующую команду
+	//
+	// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+	AddTeamMembersWithResponse(ctx context.Context, body AddTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTeamMembersResponse, error)
+
+	// ArchiveTeamWithBodyWithResponse Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+	//
+	// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+	ArchiveTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error)
+
+	// ArchiveTeamWithResponse Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+	//
+	// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+	ArchiveTeamWithResponse(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error)
+
+	// DeleteTeamWithBodyWithResponse Удалить команду
+	//
+	// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+	DeleteTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTeamResponse, error)
+
+	// DeleteTeamWithResponse Удалить команду
+	//
+	// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+	DeleteTeamWithResponse(ctx context.Context, body DeleteTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTeamResponse, error)
+
+	// GetTeamWithResponse Получить команду с участниками
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /team/get (the `GetTeam` operationId).
+	GetTeamWithResponse(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*GetTeamResponse, error)
+
+	// RemoveTeamMembersWithBodyWithResponse Исключить участников из команды (пользователи деактивируются)
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+	RemoveTeamMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTeamMembersResponse, error)
+
+	// RemoveTeamMembersWithResponse Исключить участников из команды (пользователи деактивируются)
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+	RemoveTeamMembersWithResponse(ctx context.Context, body RemoveTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTeamMembersResponse, error)
+
+	// RenameTeamWithBodyWithResponse Переименовать команду (участники переходят под новое имя)
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+	RenameTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameTeamResponse, error)
+
+	// RenameTeamWithResponse Переименовать команду (участники переходят под новое имя)
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+	RenameTeamWithResponse(ctx context.Context, body RenameTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameTeamResponse, error)
+
+	// SyncTeamsWithBodyWithResponse Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+	//
+	// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+	SyncTeamsWithBodyWithResponse(ctx context.Context, params *SyncTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncTeamsResponse, error)
+
+	// SyncTeamsWithResponse Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+	//
+	// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+	SyncTeamsWithResponse(ctx context.Context, params *SyncTeamsParams, body SyncTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncTeamsResponse, error)
+
+	// GetUserReviewsWithResponse Получить PR'ы, где пользователь назначен ревьювером
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /users/getReview (the `GetUserReviews` operationId).
+	GetUserReviewsWithResponse(ctx context.Context, params *GetUserReviewsParams, reqEditors ...RequestEditorFn) (*GetUserReviewsResponse, error)
+
+	// MoveUserTeamWithBodyWithResponse Перевести пользователя в другую команду
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+	MoveUserTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveUserTeamResponse, error)
+
+	// MoveUserTeamWithResponse Перевести пользователя в другую команду
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+	MoveUserTeamWithResponse(ctx context.Context, body MoveUserTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveUserTeamResponse, error)
+
+	// SetUserIsActiveWithBodyWithResponse Установить флаг активности пользователя
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+	SetUserIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserIsActiveResponse, error)
+
+	// SetUserIsActiveWithResponse Установить флаг активности пользователя
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+	SetUserIsActiveWithResponse(ctx context.Context, body SetUserIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserIsActiveResponse, error)
+}
+
+type CreateAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *struct {
+		Key APIKey `json:"key"`
+
+		// Token Example: rm_3f9a1c2b7d4e5f60_Zm9vYmFyYmF6cXV4Zm9vYmFyYmF6cXV4Zm9vYmFy
+		Token string `json:"token"`
+	}
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateAPIKeyResponse) GetJSON201() *struct {
+	Key APIKey `json:"key"`
+
+	// Token Example: rm_3f9a1c2b7d4e5f60_Zm9vYmFyYmF6cXV4Zm9vYmFyYmF6cXV4Zm9vYmFy
+	Token string `json:"token"`
+} {
+	return r.JSON201
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r CreateAPIKeyResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateAPIKeyResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateAPIKeyResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListAPIKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *struct {
+		Keys []APIKey `json:"keys"`
+	}
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListAPIKeysResponse) GetJSON200() *struct {
+	Keys []APIKey `json:"keys"`
+} {
+	return r.JSON200
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r ListAPIKeysResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r ListAPIKeysResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAPIKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAPIKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListAPIKeysResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RevokeAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *struct {
+		Key APIKey `json:"key"`
+	}
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RevokeAPIKeyResponse) GetJSON200() *struct {
+	Key APIKey `json:"key"`
+} {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r RevokeAPIKeyResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r RevokeAPIKeyResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r RevokeAPIKeyResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RevokeAPIKeyResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type StreamEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r StreamEventsResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r StreamEventsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r StreamEventsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ExportPullRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r ExportPullRequestsResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r ExportPullRequestsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPullRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPullRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ExportPullRequestsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ExportUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r ExportUsersResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r ExportUsersResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ExportUsersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetLivenessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *HealthReport
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetLivenessResponse) GetJSON200() *HealthReport {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r GetLivenessResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLivenessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLivenessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetLivenessResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetReadinessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *HealthReport
+	// JSON503 the response for an HTTP 503 `application/json` response
+	JSON503 *HealthReport
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetReadinessResponse) GetJSON200() *HealthReport {
+	return r.JSON200
+}
+
+// GetJSON503 returns the response for an HTTP 503 `application/json` response
+func (r GetReadinessResponse) GetJSON503() *HealthReport {
+	return r.JSON503
+}
+
+// GetBody returns the raw response body bytes
+func (r GetReadinessResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReadinessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadinessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetReadinessResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ImportUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ImportResult
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ImportUsers400JSONResponseBody
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ImportUsersResponse) GetJSON200() *ImportResult {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r ImportUsersResponse) GetJSON400() *ImportUsers400JSONResponseBody {
+	return r.JSON400
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r ImportUsersResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r ImportUsersResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ImportUsersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r GetMetricsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetMetricsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreatePullRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSON409 the response for an HTTP 409 `application/json` response
+	JSON409 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreatePullRequestResponse) GetJSON201() *struct {
+	Pr PullRequest `json:"pr"`
+} {
+	return r.JSON201
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r CreatePullRequestResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSON409 returns the response for an HTTP 409 `application/json` response
+func (r CreatePullRequestResponse) GetJSON409() *ErrorResponse {
+	return r.JSON409
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r CreatePullRequestResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r CreatePullRequestResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreatePullRequestResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// GetPullRequestResponse200Headers the declared response headers of an HTTP 200 response for GetPullRequest
+type GetPullRequestResponse200Headers struct {
+	ETag *string
+}
+
+type GetPullRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *PullRequest
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+	// Headers200 the parsed response headers for an HTTP 200 response
+	Headers200 *GetPullRequestResponse200Headers
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetPullRequestResponse) GetJSON200() *PullRequest {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r GetPullRequestResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r GetPullRequestResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r GetPullRequestResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetPullRequestResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListPullRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *PullRequestPage
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListPullRequestsResponse) GetJSON200() *PullRequestPage {
+	return r.JSON200
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r ListPullRequestsResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r ListPullRequestsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPullRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPullRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListPullRequestsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r MergePullRequestResponse) GetJSON200() *struct {
+	Pr PullRequest `json:"pr"`
+} {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r MergePullRequestResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r MergePullRequestResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r MergePullRequestResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r MergePullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergePullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r MergePullRequestResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ReassignPullRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSON409 the response for an HTTP 409 `application/json` response
+	JSON409 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ReassignPullRequestResponse) GetJSON200() *struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+} {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ReassignPullRequestResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSON409 returns the response for an HTTP 409 `application/json` response
+func (r ReassignPullRequestResponse) GetJSON409() *ErrorResponse {
+	return r.JSON409
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r ReassignPullRequestResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r ReassignPullRequestResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ReassignPullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReassignPullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ReassignPullRequestResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type AddTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *struct {
+		Team Team `json:"team"`
+	}
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ErrorResponse
+	// JSON409 the response for an HTTP 409 `application/json` response
+	JSON409 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r AddTeamResponse) GetJSON201() *struct {
+	Team Team `json:"team"`
+} {
+	return r.JSON201
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r AddTeamResponse) GetJSON400() *ErrorResponse {
+	return r.JSON400
+}
+
+// GetJSON409 returns the response for an HTTP 409 `application/json` response
+func (r AddTeamResponse) GetJSON409() *ErrorResponse {
+	return r.JSON409
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r AddTeamResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r AddTeamResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r AddTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type AddTeamMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Team
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSON409 the response for an HTTP 409 `application/json` response
+	JSON409 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r AddTeamMembersResponse) GetJSON200() *Team {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r AddTeamMembersResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSON409 returns the response for an HTTP 409 `application/json` response
+func (r AddTeamMembersResponse) GetJSON409() *ErrorResponse {
+	return r.JSON409
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r AddTeamMembersResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r AddTeamMembersResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTeamMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTeamMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r AddTeamMembersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ArchiveTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ArchivedTeam
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ArchiveTeamResponse) GetJSON200() *ArchivedTeam {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ArchiveTeamResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r ArchiveTeamResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r ArchiveTeamResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ArchiveTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Team
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSON409 the response for an HTTP 409 `application/json` response
+	JSON409 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r DeleteTeamResponse) GetJSON200() *Team {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r DeleteTeamResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSON409 returns the response for an HTTP 409 `application/json` response
+func (r DeleteTeamResponse) GetJSON409() *ErrorResponse {
+	return r.JSON409
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r DeleteTeamResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteTeamResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Team
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetTeamResponse) GetJSON200() *Team {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r GetTeamResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r GetTeamResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r GetTeamResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RemoveTeamMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *MembershipChange
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RemoveTeamMembersResponse) GetJSON200() *MembershipChange {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r RemoveTeamMembersResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r RemoveTeamMembersResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r RemoveTeamMembersResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTeamMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTeamMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RemoveTeamMembersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RenameTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Team
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ErrorResponse
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RenameTeamResponse) GetJSON200() *Team {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r RenameTeamResponse) GetJSON400() *ErrorResponse {
+	return r.JSON400
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r RenameTeamResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r RenameTeamResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r RenameTeamResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RenameTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RenameTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RenameTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type SyncTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SyncPlan
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r SyncTeamsResponse) GetJSON200() *SyncPlan {
+	return r.JSON200
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r SyncTeamsResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r SyncTeamsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r SyncTeamsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetUserReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *struct {
+		// NextCursor Курсор следующей страницы, только при заданном limit
+		NextCursor   *string            `json:"next_cursor,omitempty"`
+		PullRequests []PullRequestShort `json:"pull_requests"`
+		UserID       string             `json:"user_id"`
+	}
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetUserReviewsResponse) GetJSON200() *struct {
+	// NextCursor Курсор следующей страницы, только при заданном limit
+	NextCursor   *string            `json:"next_cursor,omitempty"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserID       string             `json:"user_id"`
+} {
+	return r.JSON200
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r GetUserReviewsResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r GetUserReviewsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetUserReviewsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type MoveUserTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *MembershipChange
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSON409 the response for an HTTP 409 `application/json` response
+	JSON409 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r MoveUserTeamResponse) GetJSON200() *MembershipChange {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r MoveUserTeamResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSON409 returns the response for an HTTP 409 `application/json` response
+func (r MoveUserTeamResponse) GetJSON409() *ErrorResponse {
+	return r.JSON409
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r MoveUserTeamResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r MoveUserTeamResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveUserTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveUserTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r MoveUserTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type SetUserIsActiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *struct {
+		User User `json:"user"`
+	}
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *ErrorResponse
+	// JSONDefault the response for an HTTP default `application/json` response
+	JSONDefault *Error
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r SetUserIsActiveResponse) GetJSON200() *struct {
+	User User `json:"user"`
+} {
+	return r.JSON200
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r SetUserIsActiveResponse) GetJSON404() *ErrorResponse {
+	return r.JSON404
+}
+
+// GetJSONDefault returns the response for an HTTP default `application/json` response
+func (r SetUserIsActiveResponse) GetJSONDefault() *Error {
+	return r.JSONDefault
+}
+
+// GetBody returns the raw response body bytes
+func (r SetUserIsActiveResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUserIsActiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUserIsActiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r SetUserIsActiveResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// CreateAPIKeyWithBodyWithResponse Выпустить API-ключ
+//
+// Токен возвращается только в этом ответе, в БД хранится его хеш.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+func (c *ClientWithResponses) CreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+// CreateAPIKeyWithResponse Выпустить API-ключ
+//
+// Токен возвращается только в этом ответе, в БД хранится его хеш.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /apiKey/create (the `CreateAPIKey` operationId).
+func (c *ClientWithResponses) CreateAPIKeyWithResponse(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+// ListAPIKeysWithResponse Список API-ключей, включая отозванные
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /apiKey/list (the `ListAPIKeys` operationId).
+func (c *ClientWithResponses) ListAPIKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error) {
+	rsp, err := c.ListAPIKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAPIKeysResponse(rsp)
+}
+
+// RevokeAPIKeyWithBodyWithResponse Отозвать API-ключ
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+func (c *ClientWithResponses) RevokeAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error) {
+	rsp, err := c.RevokeAPIKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeAPIKeyResponse(rsp)
+}
+
+// RevokeAPIKeyWithResponse Отозвать API-ключ
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /apiKey/revoke (the `RevokeAPIKey` operationId).
+func (c *ClientWithResponses) RevokeAPIKeyWithResponse(ctx context.Context, body RevokeAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error) {
+	rsp, err := c.RevokeAPIKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeAPIKeyResponse(rsp)
+}
+
+// StreamEventsWithResponse Поток назначений, переназначений и слияний PR (Server-Sent Events)
+//
+// Соединение остаётся открытым, каждое событие приходит сообщением с полем `event`,
+// равным типу события, и `AssignmentEvent` в JSON в поле `data`. Без фильтров
+// приходят все события организации. События других реплик сервиса тоже доставляются.
+// Пропущенные за время разрыва события не повторяются, после переподключения
+// актуальное состояние стоит перечитать через `/users/getReview`.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /events/stream (the `StreamEvents` operationId).
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// ExportPullRequestsWithResponse Выгрузить PR в CSV (ревьюверы через пробел)
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /export/pullRequests (the `ExportPullRequests` operationId).
+func (c *ClientWithResponses) ExportPullRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportPullRequestsResponse, error) {
+	rsp, err := c.ExportPullRequests(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPullRequestsResponse(rsp)
+}
+
+// ExportUsersWithResponse Выгрузить пользователей в CSV (формат совпадает с импортом)
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /export/users (the `ExportUsers` operationId).
+func (c *ClientWithResponses) ExportUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportUsersResponse, error) {
+	rsp, err := c.ExportUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportUsersResponse(rsp)
+}
+
+// GetLivenessWithResponse Проверка, что процесс жив (liveness)
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /health/live (the `GetLiveness` operationId).
+func (c *ClientWithResponses) GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error) {
+	rsp, err := c.GetLiveness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLivenessResponse(rsp)
+}
+
+// GetReadinessWithResponse Готовность принимать трафик (readiness)
+//
+// Проверяет соединение с БД, совпадение версии схемы с версией,
+// ожидаемой сервером, и состояние фоновых задач.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /health/ready (the `GetReadiness` operationId).
+func (c *ClientWithResponses) GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error) {
+	rsp, err := c.GetReadiness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadinessResponse(rsp)
+}
+
+// ImportUsersWithBodyWithResponse Импортировать пользователей из CSV (создаёт недостающие команды)
+//
+// Как и при добавлении участников, пользователи других команд и строки архивированных
+// команд отклоняются. Открытые ревью пользователей, которые становятся неактивными,
+// переназначаются внутри их команды.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /import/users (the `ImportUsers` operationId).
+func (c *ClientWithResponses) ImportUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportUsersResponse, error) {
+	rsp, err := c.ImportUsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportUsersResponse(rsp)
+}
+
+// GetMetricsWithResponse Метрики в формате Prometheus
+//
+// HTTP-запросы по маршрутам, состояние пула соединений с БД,
+// назначения ревьюверов, `NO_CANDIDATE`, переназначения по командам
+// и число открытых ревью у каждого пользователя.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /metrics (the `GetMetrics` operationId).
+func (c *ClientWithResponses) GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error) {
+	rsp, err := c.GetMetrics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMetricsResponse(rsp)
+}
+
+// CreatePullRequestWithBodyWithResponse Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+func (c *ClientWithResponses) CreatePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error) {
+	rsp, err := c.CreatePullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePullRequestResponse(rsp)
+}
+
+// CreatePullRequestWithResponse Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /pullRequest/create (the `CreatePullRequest` operationId).
+func (c *ClientWithResponses) CreatePullRequestWithResponse(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error) {
+	rsp, err := c.CreatePullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePullRequestResponse(rsp)
+}
+
+// GetPullRequestWithResponse Получить PR с ревьюверами
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /pullRequest/get (the `GetPullRequest` operationId).
+func (c *ClientWithResponses) GetPullRequestWithResponse(ctx context.Context, params *GetPullRequestParams, reqEditors ...RequestEditorFn) (*GetPullRequestResponse, error) {
+	rsp, err := c.GetPullRequest(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestResponse(rsp)
+}
+
+// ListPullRequestsWithResponse Получить список PR с фильтрами и постраничной выдачей (по времени создания)
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /pullRequest/list (the `ListPullRequests` operationId).
+func (c *ClientWithResponses) ListPullRequestsWithResponse(ctx context.Context, params *ListPullRequestsParams, reqEditors ...RequestEditorFn) (*ListPullRequestsResponse, error) {
+	rsp, err := c.ListPullRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPullRequestsResponse(rsp)
+}
+
+// MergePullRequestWithBodyWithResponse Пометить PR как MERGED (идемпотентная операция)
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+func (c *ClientWithResponses) MergePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error) {
+	rsp, err := c.MergePullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePullRequestResponse(rsp)
+}
+
+// MergePullRequestWithResponse Пометить PR как MERGED (идемпотентная операция)
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /pullRequest/merge (the `MergePullRequest` operationId).
+func (c *ClientWithResponses) MergePullRequestWithResponse(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error) {
+	rsp, err := c.MergePullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePullRequestResponse(rsp)
+}
+
+// ReassignPullRequestWithBodyWithResponse Переназначить конкретного ревьювера на другого из его команды
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+func (c *ClientWithResponses) ReassignPullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error) {
+	rsp, err := c.ReassignPullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignPullRequestResponse(rsp)
+}
+
+// ReassignPullRequestWithResponse Переназначить конкретного ревьювера на другого из его команды
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /pullRequest/reassign (the `ReassignPullRequest` operationId).
+func (c *ClientWithResponses) ReassignPullRequestWithResponse(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error) {
+	rsp, err := c.ReassignPullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignPullRequestResponse(rsp)
+}
+
+// AddTeamWithBodyWithResponse Создать команду с участниками (создаёт/обновляет пользователей)
+//
+// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/add (the `AddTeam` operationId).
+func (c *ClientWithResponses) AddTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTeamResponse, error) {
+	rsp, err := c.AddTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTeamResponse(rsp)
+}
+
+// AddTeamWithResponse Создать команду с участниками (создаёт/обновляет пользователей)
+//
+// Пользователи без команды становятся её участниками. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/add (the `AddTeam` operationId).
+func (c *ClientWithResponses) AddTeamWithResponse(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTeamResponse, error) {
+	rsp, err := c.AddTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTeamResponse(rsp)
+}
+
+// AddTeamMembersWithBodyWithResponse Добавить участников в существующую команду
+//
+// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+func (c *ClientWithResponses) AddTeamMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTeamMembersResponse, error) {
+	rsp, err := c.AddTeamMembersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTeamMembersResponse(rsp)
+}
+
+// AddTeamMembersWithResponse Добавить участников в существующую команду
+//
+// Новые пользователи создаются, текущие участники команды обновляются. Участников других команд нужно переводить через /users/moveTeam.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/addMembers (the `AddTeamMembers` operationId).
+func (c *ClientWithResponses) AddTeamMembersWithResponse(ctx context.Context, body AddTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTeamMembersResponse, error) {
+	rsp, err := c.AddTeamMembers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTeamMembersResponse(rsp)
+}
+
+// ArchiveTeamWithBodyWithResponse Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+//
+// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+func (c *ClientWithResponses) ArchiveTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error) {
+	rsp, err := c.ArchiveTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveTeamResponse(rsp)
+}
+
+// ArchiveTeamWithResponse Архивировать команду (участники деактивируются и снимаются с открытых ревью)
+//
+// В архивной команде нет активных участников, поэтому ревью её участников не переназначаются, а снимаются (`REMOVED`).
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/archive (the `ArchiveTeam` operationId).
+func (c *ClientWithResponses) ArchiveTeamWithResponse(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error) {
+	rsp, err := c.ArchiveTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveTeamResponse(rsp)
+}
+
+// DeleteTeamWithBodyWithResponse Удалить команду
+//
+// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+func (c *ClientWithResponses) DeleteTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTeamResponse, error) {
+	rsp, err := c.DeleteTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTeamResponse(rsp)
+}
+
+// DeleteTeamWithResponse Удалить команду
+//
+// Без target_team_name удаление запрещено, пока у команды есть открытые PR, а участники деактивируются и остаются без команды. С target_team_name участники переводятся в указанную команду, перевод в архивированную команду отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/delete (the `DeleteTeam` operationId).
+func (c *ClientWithResponses) DeleteTeamWithResponse(ctx context.Context, body DeleteTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTeamResponse, error) {
+	rsp, err := c.DeleteTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTeamResponse(rsp)
+}
+
+// GetTeamWithResponse Получить команду с участниками
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /team/get (the `GetTeam` operationId).
+func (c *ClientWithResponses) GetTeamWithResponse(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*GetTeamResponse, error) {
+	rsp, err := c.GetTeam(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamResponse(rsp)
+}
+
+// RemoveTeamMembersWithBodyWithResponse Исключить участников из команды (пользователи деактивируются)
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+func (c *ClientWithResponses) RemoveTeamMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveTeamMembersResponse, error) {
+	rsp, err := c.RemoveTeamMembersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTeamMembersResponse(rsp)
+}
+
+// RemoveTeamMembersWithResponse Исключить участников из команды (пользователи деактивируются)
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/removeMembers (the `RemoveTeamMembers` operationId).
+func (c *ClientWithResponses) RemoveTeamMembersWithResponse(ctx context.Context, body RemoveTeamMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveTeamMembersResponse, error) {
+	rsp, err := c.RemoveTeamMembers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTeamMembersResponse(rsp)
+}
+
+// RenameTeamWithBodyWithResponse Переименовать команду (участники переходят под новое имя)
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+func (c *ClientWithResponses) RenameTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameTeamResponse, error) {
+	rsp, err := c.RenameTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameTeamResponse(rsp)
+}
+
+// RenameTeamWithResponse Переименовать команду (участники переходят под новое имя)
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/rename (the `RenameTeam` operationId).
+func (c *ClientWithResponses) RenameTeamWithResponse(ctx context.Context, body RenameTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameTeamResponse, error) {
+	rsp, err := c.RenameTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameTeamResponse(rsp)
+}
+
+// SyncTeamsWithBodyWithResponse Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+//
+// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+func (c *ClientWithResponses) SyncTeamsWithBodyWithResponse(ctx context.Context, params *SyncTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncTeamsResponse, error) {
+	rsp, err := c.SyncTeamsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncTeamsResponse(rsp)
+}
+
+// SyncTeamsWithResponse Привести команды и пользователей к состоянию из ростера (JSON или YAML)
+//
+// Изменения применяются в одной транзакции. Пользователи, отсутствующие в ростере, деактивируются. Открытые ревью деактивированных и перемещённых в другую команду пользователей переназначаются внутри прежней команды. Добавление или изменение участников архивированной команды отклоняется с `TEAM_ARCHIVED`.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /team/sync (the `SyncTeams` operationId).
+func (c *ClientWithResponses) SyncTeamsWithResponse(ctx context.Context, params *SyncTeamsParams, body SyncTeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncTeamsResponse, error) {
+	rsp, err := c.SyncTeams(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncTeamsResponse(rsp)
+}
+
+// GetUserReviewsWithResponse Получить PR'ы, где пользователь назначен ревьювером
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /users/getReview (the `GetUserReviews` operationId).
+func (c *ClientWithResponses) GetUserReviewsWithResponse(ctx context.Context, params *GetUserReviewsParams, reqEditors ...RequestEditorFn) (*GetUserReviewsResponse, error) {
+	rsp, err := c.GetUserReviews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserReviewsResponse(rsp)
+}
+
+// MoveUserTeamWithBodyWithResponse Перевести пользователя в другую команду
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+func (c *ClientWithResponses) MoveUserTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveUserTeamResponse, error) {
+	rsp, err := c.MoveUserTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveUserTeamResponse(rsp)
+}
+
+// MoveUserTeamWithResponse Перевести пользователя в другую команду
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/moveTeam (the `MoveUserTeam` operationId).
+func (c *ClientWithResponses) MoveUserTeamWithResponse(ctx context.Context, body MoveUserTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveUserTeamResponse, error) {
+	rsp, err := c.MoveUserTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveUserTeamResponse(rsp)
+}
+
+// SetUserIsActiveWithBodyWithResponse Установить флаг активности пользователя
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+func (c *ClientWithResponses) SetUserIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserIsActiveResponse, error) {
+	rsp, err := c.SetUserIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserIsActiveResponse(rsp)
+}
+
+// SetUserIsActiveWithResponse Установить флаг активности пользователя
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/setIsActive (the `SetUserIsActive` operationId).
+func (c *ClientWithResponses) SetUserIsActiveWithResponse(ctx context.Context, body SetUserIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserIsActiveResponse, error) {
+	rsp, err := c.SetUserIsActive(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserIsActiveResponse(rsp)
+}
+
+// ParseCreateAPIKeyResponse parses an HTTP response from a CreateAPIKeyWithResponse call
+func ParseCreateAPIKeyResponse(rsp *http.Response) (*CreateAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Key APIKey `json:"key"`
+
+			// Token Example: rm_3f9a1c2b7d4e5f60_Zm9vYmFyYmF6cXV4Zm9vYmFyYmF6cXV4Zm9vYmFy
+			Token string `json:"token"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAPIKeysResponse parses an HTTP response from a ListAPIKeysWithResponse call
+func ParseListAPIKeysResponse(rsp *http.Response) (*ListAPIKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAPIKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Keys []APIKey `json:"keys"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeAPIKeyResponse parses an HTTP response from a RevokeAPIKeyWithResponse call
+func ParseRevokeAPIKeyResponse(rsp *http.Response) (*RevokeAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Key APIKey `json:"key"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportPullRequestsResponse parses an HTTP response from a ExportPullRequestsWithResponse call
+func ParseExportPullRequestsResponse(rsp *http.Response) (*ExportPullRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPullRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportUsersResponse parses an HTTP response from a ExportUsersWithResponse call
+func ParseExportUsersResponse(rsp *http.Response) (*ExportUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLivenessResponse parses an HTTP response from a GetLivenessWithResponse call
+func ParseGetLivenessResponse(rsp *http.Response) (*GetLivenessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLivenessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReadinessResponse parses an HTTP response from a GetReadinessWithResponse call
+func ParseGetReadinessResponse(rsp *http.Response) (*GetReadinessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReadinessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportUsersResponse parses an HTTP response from a ImportUsersWithResponse call
+func ParseImportUsersResponse(rsp *http.Response) (*ImportUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ImportUsers400JSONResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMetricsResponse parses an HTTP response from a GetMetricsWithResponse call
+func ParseGetMetricsResponse(rsp *http.Response) (*GetMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreatePullRequestResponse parses an HTTP response from a CreatePullRequestWithResponse call
+func ParseCreatePullRequestResponse(rsp *http.Response) (*CreatePullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestResponse parses an HTTP response from a GetPullRequestWithResponse call
+func ParseGetPullRequestResponse(rsp *http.Response) (*GetPullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 304:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetPullRequestResponse200Headers
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseListPullRequestsResponse parses an HTTP response from a ListPullRequestsWithResponse call
+func ParseListPullRequestsResponse(rsp *http.Response) (*ListPullRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPullRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMergePullRequestResponse parses an HTTP response from a MergePullRequestWithResponse call
+func ParseMergePullRequestResponse(rsp *http.Response) (*MergePullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MergePullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReassignPullRequestResponse parses an HTTP response from a ReassignPullRequestWithResponse call
+func ParseReassignPullRequestResponse(rsp *http.Response) (*ReassignPullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReassignPullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddTeamResponse parses an HTTP response from a AddTeamWithResponse call
+func ParseAddTeamResponse(rsp *http.Response) (*AddTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Team Team `json:"team"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddTeamMembersResponse parses an HTTP response from a AddTeamMembersWithResponse call
+func ParseAddTeamMembersResponse(rsp *http.Response) (*AddTeamMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddTeamMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchiveTeamResponse parses an HTTP response from a ArchiveTeamWithResponse call
+func ParseArchiveTeamResponse(rsp *http.Response) (*ArchiveTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchiveTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchivedTeam
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTeamResponse parses an HTTP response from a DeleteTeamWithResponse call
+func ParseDeleteTeamResponse(rsp *http.Response) (*DeleteTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamResponse parses an HTTP response from a GetTeamWithResponse call
+func ParseGetTeamResponse(rsp *http.Response) (*GetTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveTeamMembersResponse parses an HTTP response from a RemoveTeamMembersWithResponse call
+func ParseRemoveTeamMembersResponse(rsp *http.Response) (*RemoveTeamMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveTeamMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MembershipChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRenameTeamResponse parses an HTTP response from a RenameTeamWithResponse call
+func ParseRenameTeamResponse(rsp *http.Response) (*RenameTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RenameTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSyncTeamsResponse parses an HTTP response from a SyncTeamsWithResponse call
+func ParseSyncTeamsResponse(rsp *http.Response) (*SyncTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SyncTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncPlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserReviewsResponse parses an HTTP response from a GetUserReviewsWithResponse call
+func ParseGetUserReviewsResponse(rsp *http.Response) (*GetUserReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// NextCursor Курсор следующей страницы, только при заданном limit
+			NextCursor   *string            `json:"next_cursor,omitempty"`
+			PullRequests []PullRequestShort `json:"pull_requests"`
+			UserID       string             `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveUserTeamResponse parses an HTTP response from a MoveUserTeamWithResponse call
+func ParseMoveUserTeamResponse(rsp *http.Response) (*MoveUserTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveUserTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MembershipChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetUserIsActiveResponse parses an HTTP response from a SetUserIsActiveWithResponse call
+func ParseSetUserIsActiveResponse(rsp *http.Response) (*SetUserIsActiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetUserIsActiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User User `json:"user"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
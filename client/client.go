@@ -0,0 +1,5 @@
+// Package client is typed client of review manager API for other services.
+// It is generated from OpenAPI specification, so it can't drift from the server.
+package client
+
+//go:generate go tool oapi-codegen -config oapi-codegen.yaml ../api/openapi.yml
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/repository"
+	"github.com/LeonovDS/review-manager/internal/usecase/apikey"
+)
+
+// runAPIKey implements `manager apikey [--org ORG] create --name NAME --scopes read,write:pr|list|revoke ID`.
+// It is the way to issue the first admin key, further keys can be managed through API.
+func runAPIKey(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("apikey", flag.ContinueOnError)
+	name := flags.String("name", "", "name of key owner, for create")
+	scopes := flags.String("scopes", "", "comma separated scopes: read, write:pr, admin:team, for create")
+	org := flags.String("org", model.DefaultOrg, "organization of keys")
+	cfg, err := loadConfig(flags, args)
+	if err != nil {
+		return err
+	}
+	ctx = model.WithOrg(ctx, *org)
+	if flags.NArg() == 0 {
+		return errors.New("expected create, list or revoke")
+	}
+
+	pool, err := connect(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	repo := repository.APIKey{Pool: pool}
+
+	var result any
+	switch cmd := flags.Arg(0); cmd {
+	case "create":
+		var list []model.Scope
+		for s := range strings.SplitSeq(*scopes, ",") {
+			if s = strings.TrimSpace(s); len(s) != 0 {
+				list = append(list, model.Scope(s))
+			}
+		}
+		result, err = (&apikey.Creator{Key: &repo}).Create(ctx, *name, list)
+	case "list":
+		result, err = (&apikey.Lister{Key: &repo}).List(ctx)
+	case "revoke":
+		if flags.NArg() != 2 { //nolint:mnd // command and key id
+			return errors.New("revoke expects key id")
+		}
+		result, err = (&apikey.Revoker{Key: &repo}).Revoke(ctx, flags.Arg(1))
+	default:
+		return fmt.Errorf("unknown apikey command %q, expected create, list or revoke", cmd)
+	}
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const defaultProbeTimeout = 3 * time.Second
+
+// runHealthcheck implements `manager healthcheck [--url URL]`.
+// Image has no curl, so container probes call binary itself.
+func runHealthcheck(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("healthcheck", flag.ContinueOnError)
+	url := flags.String("url", "http://localhost:8080/health/ready", "probe URL")
+	timeout := flags.Duration("timeout", defaultProbeTimeout, "probe timeout")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("probe %s returned %s", *url, resp.Status)
+	}
+	return nil
+}
@@ -1,46 +1,100 @@
 // Package main starts review manager server.
+//
+// Usage:
+//
+//	manager [serve] [--config config.yaml] [--addr :8080] [--migrate=false] ...
+//	manager migrate [--db-url URL] up|down|to N|version|force N
+//	manager seed [--org ORG] [--file roster.yaml] [--pull-requests N]
+//	manager sync [--org ORG] --file roster.yaml [--dry-run]
+//	manager healthcheck [--url http://localhost:8080/health/ready]
+//	manager apikey [--org ORG] [--name NAME --scopes read,write:pr] create|list|revoke ID
+//
+// Every command accepts configuration flags, see config.Load.
 package main
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
 	"log/slog"
-	"net/http"
 	"os"
-	"time"
+	"os/signal"
+	"strings"
+	"syscall"
 
+	"github.com/LeonovDS/review-manager/internal/config"
 	"github.com/LeonovDS/review-manager/internal/database"
-	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/logging"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 )
 
 func main() {
+	// .env is convenience for local runs, in containers environment is set explicitly.
 	err := godotenv.Load()
-	if err != nil {
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
 		slog.Warn("Unable to load .env file, using system environment", slog.Any("err", err))
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	connStr := os.Getenv("DB_URL")
-	migrationSrc := os.Getenv("MIGRATION_SRC")
-	pool, err := database.Connect(ctx, connStr, migrationSrc)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	err = run(ctx, os.Args[1:])
+	stop()
 	if err != nil {
-		slog.Error("Unable to perform migrations", slog.Any("err", err))
-		return
+		slog.Error("Command failed", slog.Any("err", err))
+		os.Exit(1)
 	}
-	defer pool.Close()
+}
 
-	slog.Info("Database connection created")
+func run(ctx context.Context, args []string) error {
+	cmd := "serve"
+	if len(args) != 0 && !strings.HasPrefix(args[0], "-") {
+		cmd, args = args[0], args[1:]
+	}
+
+	switch cmd {
+	case "serve":
+		return runServe(ctx, args)
+	case "migrate":
+		return runMigrate(args)
+	case "seed":
+		return runSeed(ctx, args)
+	case "sync":
+		return runSync(ctx, args)
+	case "healthcheck":
+		return runHealthcheck(ctx, args)
+	case "apikey":
+		return runAPIKey(ctx, args)
+	default:
+		return fmt.Errorf("unknown command %q, expected serve, migrate, seed, sync, healthcheck or apikey", cmd)
+	}
+}
 
-	var server http.Server
-	server.Addr = ":8080"
-	server.Handler = handlers.NewRouter(pool)
-	server.ReadHeaderTimeout = 1 * time.Second
+// loadConfig parses command flags together with configuration and sets up default logger.
+func loadConfig(flags *flag.FlagSet, args []string) (config.Config, error) {
+	cfg, err := config.Load(flags, args, os.LookupEnv)
+	if err != nil {
+		return config.Config{}, err
+	}
 
-	err = server.ListenAndServe()
+	handler := logging.NewHandler(os.Stderr, cfg.Log.LogLevel(), cfg.Log.Format == config.FormatJSON)
+	slog.SetDefault(slog.New(telemetry.NewLogHandler(handler)))
+	return cfg, nil
+}
+
+func connect(ctx context.Context, db config.Database) (*pgxpool.Pool, error) {
+	pool, err := database.Connect(ctx, db.URL, database.PoolLimits{
+		MaxConns:        db.MaxConns,
+		MinConns:        db.MinConns,
+		MaxConnLifetime: db.MaxConnLifetime,
+		MaxConnIdleTime: db.MaxConnIdleTime,
+	})
 	if err != nil {
-		slog.Error("Failed to start server", slog.Any("err", err))
-		return
+		return nil, err
 	}
+
+	slog.Info("Database connection created")
+	return pool, nil
 }
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+)
+
+var errMigrateUsage = errors.New("usage: manager migrate [flags] up|down|to N|version|force N")
+
+// runMigrate implements `manager migrate up|down|to N|version|force N`.
+func runMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	cfg, err := loadConfig(flags, args)
+	if err != nil {
+		return err
+	}
+	args = flags.Args()
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+
+	m, err := database.NewMigrator(cfg.Database.URL, cfg.Database.MigrationSrc)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = m.Close() }()
+
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		return m.Up()
+	case args[0] == "down" && len(args) == 1:
+		return m.Down()
+	case args[0] == "version" && len(args) == 1:
+		version, dirty, err := m.Version()
+		if err != nil {
+			return err
+		}
+		if dirty {
+			_, err = fmt.Fprintf(os.Stdout, "%d (dirty)\n", version)
+			return err
+		}
+		_, err = fmt.Fprintf(os.Stdout, "%d\n", version)
+		return err
+	case args[0] == "to" && len(args) == 2:
+		version, err := strconv.ParseUint(args[1], 10, 0)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errMigrateUsage, err)
+		}
+		return m.To(uint(version))
+	case args[0] == "force" && len(args) == 2:
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("%w: %w", errMigrateUsage, err)
+		}
+		return m.Force(version)
+	default:
+		return errMigrateUsage
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/repository"
+	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+)
+
+//go:embed seed/roster.yaml
+var seedFS embed.FS
+
+const defaultSeedPRs = 5
+
+// runSeed implements `manager seed [--org ORG] [--file roster.yaml] [--pull-requests N]`.
+// Seeding is idempotent: roster is synced and existing pull requests are skipped.
+func runSeed(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	file := flags.String("file", "", "roster to seed instead of built-in sample")
+	prCount := flags.Int("pull-requests", defaultSeedPRs, "number of sample pull requests")
+	org := flags.String("org", model.DefaultOrg, "organization to seed")
+	cfg, err := loadConfig(flags, args)
+	if err != nil {
+		return err
+	}
+	ctx = model.WithOrg(ctx, *org)
+
+	var data []byte
+	if len(*file) == 0 {
+		data, err = seedFS.ReadFile("seed/roster.yaml")
+	} else {
+		data, err = os.ReadFile(*file)
+	}
+	if err != nil {
+		return err
+	}
+
+	roster, err := team.ParseRoster(data)
+	if err != nil {
+		return err
+	}
+
+	pool, err := connect(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	tm := database.DBTransactionManager{Pool: pool}
+	teamRepo := repository.Team{Pool: pool}
+	prRepo := repository.PullRequest{Pool: pool}
+	userRepo := repository.User{Pool: pool}
+
+	err = tm.WithTransaction(ctx, func(ctx context.Context) error {
+		return seedTeams(ctx, &teamRepo, &userRepo, roster)
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("Seeded teams", slog.Int("count", len(roster.Teams)))
+
+	creator := pullrequest.Creator{
+		TX: &tm, PR: &prRepo, User: &userRepo, Reviewers: cfg.Assignment.Reviewers, Metrics: nil, Events: nil,
+	}
+	authors := activeMembers(roster)
+	for i := range *prCount {
+		if len(authors) == 0 {
+			break
+		}
+		id := fmt.Sprintf("seed-pr-%d", i+1)
+		_, err = creator.Create(ctx, id, "Sample pull request "+id, authors[i%len(authors)])
+		if err != nil && !errors.Is(err, model.ErrPRExists) {
+			return err
+		}
+	}
+	slog.Info("Seeded pull requests", slog.Int("count", *prCount))
+	return nil
+}
+
+// seedTeams creates missing teams and creates or updates their members, leaving other users intact.
+func seedTeams(
+	ctx context.Context, teamRepo *repository.Team, userRepo *repository.User, roster model.Roster,
+) error {
+	for _, t := range roster.Teams {
+		_, err := teamRepo.Add(ctx, t)
+		if err != nil && !errors.Is(err, model.ErrTeamExists) {
+			return err
+		}
+
+		err = userRepo.Add(ctx, t)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func activeMembers(roster model.Roster) []string {
+	var res []string
+	for _, t := range roster.Teams {
+		for _, m := range t.Members {
+			if m.IsActive {
+				res = append(res, m.UserID)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/LeonovDS/review-manager/internal/config"
+	"github.com/LeonovDS/review-manager/internal/contract"
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/health"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/metrics"
+	"github.com/LeonovDS/review-manager/internal/oidc"
+	"github.com/LeonovDS/review-manager/internal/repository"
+	"github.com/LeonovDS/review-manager/internal/rpc"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// worker is background process living as long as server. Run must return when ctx is done.
+type worker struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// idempotencyCleanupInterval is how often expired idempotency keys are deleted.
+const idempotencyCleanupInterval = time.Hour
+
+// idempotencyCleanup deletes idempotency keys older than ttl. Failed cleanup is retried on next tick.
+func idempotencyCleanup(repo *repository.Idempotency, ttl time.Duration) worker {
+	return worker{name: "idempotency-cleanup", run: func(ctx context.Context) error {
+		ticker := time.NewTicker(idempotencyCleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+
+			deleted, err := repo.DeleteExpired(ctx, ttl)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to delete expired idempotency keys", slog.Any("err", err))
+				continue
+			}
+			slog.DebugContext(ctx, "Expired idempotency keys deleted", slog.Int64("count", deleted))
+		}
+	}}
+}
+
+// jwksRefresh reloads key set of OpenID Connect provider to follow key rotation.
+// Failed refresh keeps current keys and is retried on next tick.
+func jwksRefresh(verifier *oidc.Verifier, interval time.Duration) worker {
+	return worker{name: "jwks-refresh", run: func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+
+			err := verifier.Refresh(ctx)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to refresh JWKS", slog.Any("err", err))
+			}
+		}
+	}}
+}
+
+// eventsRelisten is delay before listening to assignment events again after connection is lost.
+const eventsRelisten = 5 * time.Second
+
+// eventsListener passes assignment events published by every replica to broker of this one.
+// Lost connection is reopened after delay, events published meanwhile are not delivered.
+func eventsListener(repo *repository.Events, broker *events.Broker) worker {
+	return worker{name: "events-listener", run: func(ctx context.Context) error {
+		for {
+			err := repo.Listen(ctx, broker.Publish)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			slog.WarnContext(ctx, "Stopped listening to assignment events", slog.Any("err", err))
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(eventsRelisten):
+			}
+		}
+	}}
+}
+
+// grpcServe serves gRPC API on addr. On shutdown calls are given timeout to finish, then connections are closed.
+func grpcServe(server *grpc.Server, addr string, shutdownTimeout time.Duration) worker {
+	return worker{name: "grpc", run: func(ctx context.Context) error {
+		lis, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr) //nolint:exhaustruct
+		if err != nil {
+			return err
+		}
+		serveErr := make(chan error, 1)
+		go func() { serveErr <- server.Serve(lis) }()
+		slog.InfoContext(ctx, "gRPC server started", slog.String("addr", addr))
+
+		select {
+		case err = <-serveErr:
+			return err
+		case <-ctx.Done():
+		}
+
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+		timer := time.NewTimer(shutdownTimeout)
+		defer timer.Stop()
+		select {
+		case <-stopped:
+		case <-timer.C:
+			slog.Warn("In-flight calls were not finished, closing connections")
+			server.Stop()
+			<-stopped
+		}
+		return ctx.Err()
+	}}
+}
+
+// runServe implements `manager serve [flags]`.
+func runServe(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	cfg, err := loadConfig(flags, args)
+	if err != nil {
+		return err
+	}
+	slog.Info("Configuration loaded", slog.Any("config", cfg))
+
+	shutdownTracing, err := telemetry.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		return err
+	}
+	// Spans are flushed after everything else is stopped.
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		err := shutdownTracing(flushCtx)
+		if err != nil {
+			slog.Warn("Failed to flush traces", slog.Any("err", err))
+		}
+	}()
+
+	if cfg.Database.Migrate {
+		err = database.MigrateUp(cfg.Database.URL, cfg.Database.MigrationSrc)
+		if err != nil {
+			return err
+		}
+	}
+
+	pool, err := connect(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	// Pool is closed last, after requests are drained and workers are stopped.
+	defer pool.Close()
+
+	var workers []worker
+	var status health.Workers
+	if cfg.Server.IdempotencyTTL > 0 {
+		workers = append(workers, idempotencyCleanup(&repository.Idempotency{Pool: pool}, cfg.Server.IdempotencyTTL))
+	}
+	var m *metrics.Metrics
+	if cfg.Features.Metrics {
+		m = metrics.New()
+	}
+	var validator *contract.Validator
+	if cfg.Server.Contract != config.ContractOff {
+		validator, err = contract.New(cfg.Server.Contract == config.ContractStrict)
+		if err != nil {
+			return err
+		}
+	}
+	var security map[string]contract.Requirement
+	var verifier *oidc.Verifier
+	if cfg.Server.Auth {
+		security, err = contract.Security()
+		if err != nil {
+			return err
+		}
+		if len(cfg.OIDC.JWKS) != 0 {
+			verifier, err = oidc.New(ctx, oidc.Config{
+				JWKS:       cfg.OIDC.JWKS,
+				Issuer:     cfg.OIDC.Issuer,
+				Audience:   cfg.OIDC.Audience,
+				UserClaim:  cfg.OIDC.UserClaim,
+				RolesClaim: cfg.OIDC.RolesClaim,
+				OrgClaim:   cfg.OIDC.OrgClaim,
+			})
+			if err != nil {
+				return err
+			}
+			if verifier.Remote() {
+				workers = append(workers, jwksRefresh(verifier, cfg.OIDC.JWKSRefresh))
+			}
+		}
+	} else {
+		slog.Warn("Authentication is disabled, API is open to anyone who can reach it")
+	}
+	// Events committed by any replica are delivered to streams of both APIs.
+	broker := events.NewBroker()
+	// Both APIs share one database pool, so they share its limits too.
+	limiter := limit.NewLimiter(rateLimits(cfg.RateLimit), time.Now)
+	inFlight := limit.NewSlots(cfg.RateLimit.MaxInFlight)
+	workers = append(workers, eventsListener(&repository.Events{Pool: pool}, broker))
+	if len(cfg.Server.GRPCAddr) != 0 {
+		workers = append(workers, grpcServe(rpc.NewServer(pool, rpc.Options{
+			Reviewers:   cfg.Assignment.Reviewers,
+			Metrics:     m,
+			Events:      broker,
+			Auth:        cfg.Server.Auth,
+			JWT:         verifier,
+			RateLimiter: limiter,
+			InFlight:    inFlight,
+		}), cfg.Server.GRPCAddr, cfg.Server.ShutdownTimeout))
+	}
+	server := http.Server{ //nolint:exhaustruct
+		Addr: cfg.Server.Addr,
+		Handler: handlers.NewRouter(pool, handlers.Options{
+			Reviewers:        cfg.Assignment.Reviewers,
+			Transfer:         cfg.Features.Transfer,
+			Sync:             cfg.Features.Sync,
+			Workers:          &status,
+			Metrics:          m,
+			Contract:         validator,
+			IdempotencyTTL:   cfg.Server.IdempotencyTTL,
+			IdempotencyLease: cfg.Server.WriteTimeout,
+			Security:         security,
+			JWT:              verifier,
+			RateLimiter:      limiter,
+			InFlight:         inFlight,
+			Events:           broker,
+		}),
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+	// Streams of events are never finished by clients, so they are ended when shutdown begins.
+	server.RegisterOnShutdown(broker.Close)
+
+	return serve(ctx, &server, cfg.Server.ShutdownTimeout, workers, &status)
+}
+
+// rateLimits converts configuration of rate limiter to its rates.
+func rateLimits(cfg config.RateLimit) limit.Rates {
+	routes := make(map[string]limit.Rate, len(cfg.Routes))
+	for route, r := range cfg.Routes {
+		routes[route] = limit.Rate{Rate: r.Rate, Burst: r.Burst}
+	}
+	return limit.Rates{
+		Default: limit.Rate{Rate: cfg.Rate, Burst: cfg.Burst},
+		Routes:  routes,
+	}
+}
+
+// serve runs server and workers until ctx is done or one of them fails.
+// On shutdown in-flight requests are drained first, then workers are stopped.
+// Workers state is reported to status for readiness probe.
+func serve(
+	ctx context.Context, server *http.Server, shutdownTimeout time.Duration,
+	workers []worker, status *health.Workers,
+) error {
+	workerCtx, stopWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopWorkers()
+
+	var wg sync.WaitGroup
+	workerErr := make(chan error, len(workers))
+	for _, w := range workers {
+		status.Running(w.name)
+		wg.Go(func() {
+			err := w.run(workerCtx)
+			status.Stopped(w.name, err)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				workerErr <- fmt.Errorf("%s: %w", w.name, err)
+			}
+		})
+	}
+
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- server.ListenAndServe() }()
+	slog.Info("Server started", slog.String("addr", server.Addr))
+
+	var runErr error
+	select {
+	case runErr = <-serveErr:
+		stopWorkers()
+		wg.Wait()
+		return runErr
+	case runErr = <-workerErr:
+		slog.Error("Background worker failed", slog.Any("err", runErr))
+	case <-ctx.Done():
+	}
+
+	slog.Info("Shutting down server", slog.Duration("timeout", shutdownTimeout))
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+	err := server.Shutdown(shutdownCtx)
+	if err != nil {
+		slog.Warn("In-flight requests were not drained, closing connections", slog.Any("err", err))
+		_ = server.Close()
+	}
+
+	stopWorkers()
+	wg.Wait()
+	slog.Info("Server stopped")
+	return runErr
+}
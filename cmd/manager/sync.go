@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+
+	"github.com/LeonovDS/review-manager/internal/app"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+)
+
+// runSync implements `manager sync [--org ORG] --file roster.yaml [--dry-run]`.
+func runSync(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("sync", flag.ContinueOnError)
+	file := flags.String("file", "", "path to roster in YAML or JSON format")
+	dryRun := flags.Bool("dry-run", false, "print plan without applying it")
+	org := flags.String("org", model.DefaultOrg, "organization that roster describes")
+	cfg, err := loadConfig(flags, args)
+	if err != nil {
+		return err
+	}
+	ctx = model.WithOrg(ctx, *org)
+	if len(*file) == 0 {
+		return errors.New("--file is required")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+
+	roster, err := team.ParseRoster(data)
+	if err != nil {
+		return err
+	}
+
+	pool, err := connect(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	// Only syncer is used, assignment options don't matter.
+	syncer := app.New(pool, app.Options{Reviewers: 0, Metrics: nil, JWT: nil}).TeamSync
+	plan, err := syncer.Sync(ctx, roster, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(plan)
+}
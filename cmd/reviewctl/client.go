@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/LeonovDS/review-manager/client"
+)
+
+const requestTimeout = 30 * time.Second
+
+// newClient creates typed client of server from config, token is sent with every request.
+func newClient(cfg config) (*client.ClientWithResponses, error) {
+	return client.NewClientWithResponses(cfg.Server,
+		client.WithHTTPClient(&http.Client{Timeout: requestTimeout}), //nolint:exhaustruct
+		client.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			if len(cfg.Token) != 0 {
+				req.Header.Set("Authorization", "Bearer "+cfg.Token)
+			}
+			return nil
+		}),
+	)
+}
+
+// responseError is error response of API.
+type responseError struct {
+	status int
+	body   client.ErrorResponse
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("%s: %s (HTTP %d)", e.body.Error.Code, e.body.Error.Message, e.status)
+}
+
+// code returns error code of API.
+func (e *responseError) code() string {
+	return string(e.body.Error.Code)
+}
+
+// result returns data of successful response. Otherwise, error of API is decoded from body,
+// responses without it, e.g. from proxies, are reported as INTERNAL_ERROR.
+func result[T any](data *T, resp *http.Response, body []byte) (*T, error) {
+	if data != nil {
+		return data, nil
+	}
+	e := &responseError{status: resp.StatusCode, body: client.ErrorResponse{}}
+	err := json.Unmarshal(body, &e.body)
+	if err != nil || len(e.body.Error.Code) == 0 {
+		e.body.Error.Code = client.ErrorResponseErrorCodeINTERNALERROR
+		e.body.Error.Message = resp.Status
+	}
+	return nil, e
+}
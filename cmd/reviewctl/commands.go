@@ -0,0 +1,347 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/LeonovDS/review-manager/client"
+)
+
+// command executes subcommands against API.
+type command struct {
+	api *client.ClientWithResponses
+	out *printer
+}
+
+func (c *command) dispatch(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: command is required", errUsage)
+	}
+	if args[0] == "stats" {
+		return c.stats(ctx, args[1:])
+	}
+	if len(args) < 2 { //nolint:mnd // group and command
+		return fmt.Errorf("%w: %s requires subcommand", errUsage, args[0])
+	}
+
+	switch args[0] + " " + args[1] {
+	case "team add":
+		return c.teamAdd(ctx, args[2:])
+	case "team get":
+		return c.teamGet(ctx, args[2:])
+	case "user set-active":
+		return c.userSetActive(ctx, args[2:])
+	case "pr create":
+		return c.prCreate(ctx, args[2:])
+	case "pr merge":
+		return c.prMerge(ctx, args[2:])
+	case "pr reassign":
+		return c.prReassign(ctx, args[2:])
+	case "pr list":
+		return c.prList(ctx, args[2:])
+	default:
+		return fmt.Errorf("%w: unknown command %s %s", errUsage, args[0], args[1])
+	}
+}
+
+// members is repeatable flag of ID=USERNAME pairs.
+type members struct {
+	users    *[]client.TeamMember
+	isActive bool
+}
+
+func (m members) String() string { return "" }
+
+func (m members) Set(value string) error {
+	id, name, ok := strings.Cut(value, "=")
+	if !ok || len(id) == 0 || len(name) == 0 {
+		return fmt.Errorf("member must be ID=USERNAME, got %q", value)
+	}
+	*m.users = append(*m.users, client.TeamMember{UserID: id, Username: name, IsActive: m.isActive})
+	return nil
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	return flags
+}
+
+func parseFlags(flags *flag.FlagSet, args []string, positional int) ([]string, error) {
+	err := flags.Parse(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", errUsage, flags.Name(), err)
+	}
+	if flags.NArg() != positional {
+		return nil, fmt.Errorf("%w: %s expects %d arguments", errUsage, flags.Name(), positional)
+	}
+	return flags.Args(), nil
+}
+
+func (c *command) teamAdd(ctx context.Context, args []string) error {
+	team := client.Team{TeamName: "", Members: []client.TeamMember{}, Archived: nil}
+	flags := newFlagSet("team add")
+	flags.StringVar(&team.TeamName, "name", "", "team name")
+	flags.Var(members{users: &team.Members, isActive: true}, "member", "active member ID=USERNAME")
+	flags.Var(members{users: &team.Members, isActive: false}, "inactive", "inactive member ID=USERNAME")
+	_, err := parseFlags(flags, args, 0)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.AddTeamWithResponse(ctx, team)
+	if err != nil {
+		return err
+	}
+	res, err := result(resp.JSON201, resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return err
+	}
+	return c.out.team(res.Team)
+}
+
+func (c *command) teamGet(ctx context.Context, args []string) error {
+	pos, err := parseFlags(newFlagSet("team get"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.GetTeamWithResponse(ctx, &client.GetTeamParams{TeamName: pos[0]})
+	if err != nil {
+		return err
+	}
+	res, err := result(resp.JSON200, resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return err
+	}
+	return c.out.team(*res)
+}
+
+func (c *command) userSetActive(ctx context.Context, args []string) error {
+	pos, err := parseFlags(newFlagSet("user set-active"), args, 2) //nolint:mnd
+	if err != nil {
+		return err
+	}
+	isActive, err := strconv.ParseBool(pos[1])
+	if err != nil {
+		return fmt.Errorf("%w: user set-active expects true or false", errUsage)
+	}
+
+	resp, err := c.api.SetUserIsActiveWithResponse(ctx, client.SetUserIsActiveJSONRequestBody{
+		UserID:   pos[0],
+		IsActive: isActive,
+	})
+	if err != nil {
+		return err
+	}
+	res, err := result(resp.JSON200, resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return err
+	}
+	return c.out.user(res.User)
+}
+
+func (c *command) prCreate(ctx context.Context, args []string) error {
+	flags := newFlagSet("pr create")
+	id := flags.String("id", "", "pull request id")
+	name := flags.String("name", "", "pull request name")
+	author := flags.String("author", "", "author user id")
+	_, err := parseFlags(flags, args, 0)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.CreatePullRequestWithResponse(ctx, client.CreatePullRequestJSONRequestBody{
+		PullRequestID:   *id,
+		PullRequestName: *name,
+		AuthorID:        *author,
+	})
+	if err != nil {
+		return err
+	}
+	res, err := result(resp.JSON201, resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return err
+	}
+	return c.out.pullRequests(res, []client.PullRequest{res.Pr})
+}
+
+func (c *command) prMerge(ctx context.Context, args []string) error {
+	pos, err := parseFlags(newFlagSet("pr merge"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.MergePullRequestWithResponse(ctx, client.MergePullRequestJSONRequestBody{PullRequestID: pos[0]})
+	if err != nil {
+		return err
+	}
+	res, err := result(resp.JSON200, resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return err
+	}
+	return c.out.pullRequests(res, []client.PullRequest{res.Pr})
+}
+
+func (c *command) prReassign(ctx context.Context, args []string) error {
+	pos, err := parseFlags(newFlagSet("pr reassign"), args, 2) //nolint:mnd
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.ReassignPullRequestWithResponse(ctx, client.ReassignPullRequestJSONRequestBody{
+		PullRequestID: pos[0],
+		OldUserID:     pos[1],
+	})
+	if err != nil {
+		return err
+	}
+	res, err := result(resp.JSON200, resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return err
+	}
+	return c.out.print(res, []string{"PULL_REQUEST_ID", "NEW_REVIEWER"}, [][]string{
+		{res.Pr.PullRequestID, res.ReplacedBy},
+	})
+}
+
+func (c *command) prList(ctx context.Context, args []string) error {
+	flags := newFlagSet("pr list")
+	var params client.ListPullRequestsParams
+	flags.Func("status", "filter by status", func(v string) error {
+		status := client.ListPullRequestsParamsStatus(v)
+		params.Status = &status
+		return nil
+	})
+	flags.Func("author", "filter by author", stringParam(&params.AuthorID))
+	flags.Func("reviewer", "filter by reviewer", stringParam(&params.ReviewerID))
+	flags.Func("team", "filter by team", stringParam(&params.TeamName))
+	flags.Func("name", "filter by name", stringParam(&params.Name))
+	flags.Func("cursor", "start from cursor", stringParam(&params.Cursor))
+	flags.Func("limit", "page size", func(v string) error {
+		limit, err := strconv.Atoi(v)
+		params.Limit = &limit
+		return err
+	})
+	all := flags.Bool("all", false, "fetch all pages")
+	_, err := parseFlags(flags, args, 0)
+	if err != nil {
+		return err
+	}
+
+	page, err := c.listPages(ctx, &params, *all)
+	if err != nil {
+		return err
+	}
+	return c.out.pullRequests(page, page.PullRequests)
+}
+
+// stringParam sets optional query parameter from flag.
+func stringParam(param **string) func(string) error {
+	return func(v string) error {
+		*param = &v
+		return nil
+	}
+}
+
+// listPages fetches one page of pull requests, or all pages if all is set.
+func (c *command) listPages(
+	ctx context.Context, params *client.ListPullRequestsParams, all bool,
+) (client.PullRequestPage, error) {
+	res := client.PullRequestPage{PullRequests: []client.PullRequest{}, Total: 0, NextCursor: nil}
+	for {
+		resp, err := c.api.ListPullRequestsWithResponse(ctx, params)
+		if err != nil {
+			return client.PullRequestPage{}, err
+		}
+		page, err := result(resp.JSON200, resp.HTTPResponse, resp.Body)
+		if err != nil {
+			return client.PullRequestPage{}, err
+		}
+
+		res.PullRequests = append(res.PullRequests, page.PullRequests...)
+		res.Total = page.Total
+		res.NextCursor = page.NextCursor
+		if !all || page.NextCursor == nil {
+			return res, nil
+		}
+		params.Cursor = page.NextCursor
+	}
+}
+
+// userStats counts reviews assigned to one user.
+type userStats struct {
+	UserID string `json:"user_id"`
+	Open   int    `json:"open_reviews"`
+	Merged int    `json:"merged_reviews"`
+}
+
+// stats summarizes assignments per user from all pull requests.
+type stats struct {
+	PullRequests int         `json:"pull_requests"`
+	Open         int         `json:"open"`
+	Merged       int         `json:"merged"`
+	Reviewers    []userStats `json:"reviewers"`
+}
+
+func (c *command) stats(ctx context.Context, args []string) error {
+	flags := newFlagSet("stats")
+	team := flags.String("team", "", "only pull requests authored in team")
+	_, err := parseFlags(flags, args, 0)
+	if err != nil {
+		return err
+	}
+
+	var params client.ListPullRequestsParams
+	if len(*team) != 0 {
+		params.TeamName = team
+	}
+	page, err := c.listPages(ctx, &params, true)
+	if err != nil {
+		return err
+	}
+
+	res := collectStats(page.PullRequests)
+	rows := make([][]string, 0, len(res.Reviewers))
+	for _, s := range res.Reviewers {
+		rows = append(rows, []string{s.UserID, strconv.Itoa(s.Open), strconv.Itoa(s.Merged)})
+	}
+	return c.out.print(res, []string{"REVIEWER", "OPEN", "MERGED"}, rows)
+}
+
+func collectStats(prs []client.PullRequest) stats {
+	res := stats{PullRequests: len(prs), Open: 0, Merged: 0, Reviewers: []userStats{}}
+	byUser := map[string]*userStats{}
+	for _, pr := range prs {
+		open := pr.Status == client.PullRequestStatusOPEN
+		if open {
+			res.Open++
+		} else {
+			res.Merged++
+		}
+
+		for _, id := range pr.AssignedReviewers {
+			s, ok := byUser[id]
+			if !ok {
+				s = &userStats{UserID: id, Open: 0, Merged: 0}
+				byUser[id] = s
+			}
+			if open {
+				s.Open++
+			} else {
+				s.Merged++
+			}
+		}
+	}
+
+	for _, s := range byUser {
+		res.Reviewers = append(res.Reviewers, *s)
+	}
+	slices.SortFunc(res.Reviewers, func(a, b userStats) int { return strings.Compare(a.UserID, b.UserID) })
+	return res
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/client"
+)
+
+// newTestCommand creates command talking to fake server.
+func newTestCommand(t *testing.T, handler http.HandlerFunc) *command {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	api, err := newClient(config{Server: srv.URL, Token: "secret", Output: outputJSON})
+	require.NoError(t, err)
+	return &command{api: api, out: &printer{w: io.Discard, json: true}}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	assert.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+//nolint:exhaustruct
+func TestCollectStats(t *testing.T) {
+	prs := []client.PullRequest{
+		{PullRequestID: "pr1", Status: client.PullRequestStatusOPEN, AssignedReviewers: []string{"u2", "u3"}},
+		{PullRequestID: "pr2", Status: client.PullRequestStatusMERGED, AssignedReviewers: []string{"u3"}},
+		{PullRequestID: "pr3", Status: client.PullRequestStatusOPEN, AssignedReviewers: []string{}},
+	}
+
+	assert.Equal(t, stats{
+		PullRequests: 3,
+		Open:         2,
+		Merged:       1,
+		Reviewers: []userStats{
+			{UserID: "u2", Open: 1, Merged: 0},
+			{UserID: "u3", Open: 1, Merged: 1},
+		},
+	}, collectStats(prs))
+	assert.Equal(t, stats{PullRequests: 0, Open: 0, Merged: 0, Reviewers: []userStats{}}, collectStats(nil))
+}
+
+func TestMembersSet(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "u1=Alice", valid: true},
+		{value: "u1=Alice=Smith", valid: true},
+		{value: "u1", valid: false},
+		{value: "=Alice", valid: false},
+		{value: "u1=", valid: false},
+		{value: "", valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			var users []client.TeamMember
+			err := members{users: &users, isActive: false}.Set(tt.value)
+			if !tt.valid {
+				assert.Error(t, err)
+				assert.Empty(t, users)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, users, 1)
+		})
+	}
+
+	// Active and inactive members are collected into one team in order of flags.
+	var users []client.TeamMember
+	require.NoError(t, members{users: &users, isActive: true}.Set("u1=Alice"))
+	require.NoError(t, members{users: &users, isActive: false}.Set("u2=Bob=Smith"))
+	assert.Equal(t, []client.TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob=Smith", IsActive: false},
+	}, users)
+}
+
+//nolint:exhaustruct
+func TestListPages(t *testing.T) {
+	next := map[string]string{"": "c1", "c1": "c2"}
+	var cursors []string
+	cmd := newTestCommand(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/pullRequest/list", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "backend", r.URL.Query().Get("team_name"))
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		page := client.PullRequestPage{
+			PullRequests: []client.PullRequest{{PullRequestID: "after-" + cursor, AssignedReviewers: []string{}}},
+			Total:        3,
+		}
+		if c, ok := next[cursor]; ok {
+			page.NextCursor = &c
+		}
+		writeJSON(t, w, http.StatusOK, page)
+	})
+	team := "backend"
+
+	page, err := cmd.listPages(t.Context(), &client.ListPullRequestsParams{TeamName: &team}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, cursors)
+	assert.Len(t, page.PullRequests, 1)
+	require.NotNil(t, page.NextCursor)
+	assert.Equal(t, "c1", *page.NextCursor)
+
+	// With --all cursors are followed until last page.
+	cursors = nil
+	page, err = cmd.listPages(t.Context(), &client.ListPullRequestsParams{TeamName: &team}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "c1", "c2"}, cursors)
+	ids := make([]string, 0, len(page.PullRequests))
+	for _, pr := range page.PullRequests {
+		ids = append(ids, pr.PullRequestID)
+	}
+	assert.Equal(t, []string{"after-", "after-c1", "after-c2"}, ids)
+	assert.Equal(t, 3, page.Total)
+	assert.Nil(t, page.NextCursor)
+}
+
+func TestListPages_Error(t *testing.T) {
+	calls := 0
+	cmd := newTestCommand(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			next := "c1"
+			writeJSON(t, w, http.StatusOK, client.PullRequestPage{
+				PullRequests: []client.PullRequest{}, Total: 1, NextCursor: &next,
+			})
+			return
+		}
+		writeJSON(t, w, http.StatusTooManyRequests, map[string]any{
+			"error": map[string]string{"code": "TOO_MANY_REQUESTS", "message": "rate limit exceeded"},
+		})
+	})
+
+	_, err := cmd.listPages(t.Context(), &client.ListPullRequestsParams{}, true) //nolint:exhaustruct
+	var apiErr *responseError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "TOO_MANY_REQUESTS", apiErr.code())
+}
+
+func TestRunExitCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		status int
+		body   string
+		exit   int
+	}{
+		{
+			name: "success", args: []string{"team", "get", "backend"},
+			status: http.StatusOK, body: `{"team_name":"backend","members":[]}`, exit: exitOK,
+		},
+		{
+			name: "not found", args: []string{"team", "get", "backend"},
+			status: http.StatusNotFound, body: `{"error":{"code":"NOT_FOUND","message":"team not found"}}`, exit: 3,
+		},
+		{
+			name: "request in progress", args: []string{"pr", "merge", "pr1"},
+			status: http.StatusConflict, body: `{"error":{"code":"REQUEST_IN_PROGRESS","message":"in progress"}}`,
+			exit: 19,
+		},
+		{
+			name: "proxy error", args: []string{"pr", "merge", "pr1"},
+			status: http.StatusBadGateway, body: `<html>Bad Gateway</html>`, exit: exitInternal,
+		},
+		{name: "unknown command", args: []string{"pr", "close"}, exit: exitUsage},
+		{name: "bad flag", args: []string{"team", "add", "--member", "u1"}, exit: exitUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			var stdout, stderr bytes.Buffer
+			args := append([]string{
+				"--config", filepath.Join(t.TempDir(), "config.yaml"), "--server", srv.URL, "--output", outputJSON,
+			}, tt.args...)
+			assert.Equal(t, tt.exit, run(t.Context(), args, &stdout, &stderr), stderr.String())
+			if tt.exit == exitOK {
+				assert.JSONEq(t, tt.body, stdout.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// config holds connection settings loaded from config file, environment and flags.
+type config struct {
+	Server string `yaml:"server"`
+	Token  string `yaml:"token"`
+	Output string `yaml:"output"`
+}
+
+const defaultServer = "http://localhost:8080"
+
+// defaultConfigPath returns $XDG_CONFIG_HOME/reviewctl/config.yaml or its fallback in home directory.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "reviewctl", "config.yaml")
+}
+
+// loadConfig reads config file, missing file is not an error. Environment overrides file.
+func loadConfig(path string) (config, error) {
+	cfg := config{Server: defaultServer, Token: "", Output: outputTable}
+
+	if len(path) != 0 {
+		data, err := os.ReadFile(path) // #nosec G304 - path is provided by user
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return config{}, err
+		}
+		if err == nil {
+			err = yaml.Unmarshal(data, &cfg)
+			if err != nil {
+				return config{}, err
+			}
+		}
+	}
+
+	server, ok := os.LookupEnv("REVIEWCTL_SERVER")
+	if ok {
+		cfg.Server = server
+	}
+	token, ok := os.LookupEnv("REVIEWCTL_TOKEN")
+	if ok {
+		cfg.Token = token
+	}
+	return cfg, nil
+}
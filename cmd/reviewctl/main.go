@@ -0,0 +1,115 @@
+// Package main is command line tool for operating review manager over HTTP API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+const usage = `Usage: reviewctl [flags] <command> [args]
+
+Commands:
+  team add --name NAME --member ID=USERNAME [--member ...] [--inactive ID=USERNAME ...]
+  team get NAME
+  user set-active USER_ID true|false
+  pr create --id ID --name NAME --author USER_ID
+  pr merge ID
+  pr reassign ID OLD_USER_ID
+  pr list [--status S] [--author ID] [--reviewer ID] [--team NAME] [--name TEXT] [--limit N] [--all]
+  stats [--team NAME]
+
+Flags:
+`
+
+// Exit codes. API errors are mapped by their code, see model.ErrorKind.
+const (
+	exitOK       = 0
+	exitInternal = 1
+	exitUsage    = 64
+)
+
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("reviewctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		_, _ = fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	configPath := flags.String("config", defaultConfigPath(), "path to config file")
+	server := flags.String("server", "", "server URL, overrides config")
+	token := flags.String("token", "", "API token, overrides config")
+	output := flags.String("output", "", "output format: table or json")
+	err := flags.Parse(args)
+	if err != nil {
+		return exitUsage
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "Failed to load config:", err)
+		return exitUsage
+	}
+	if len(*server) != 0 {
+		cfg.Server = *server
+	}
+	if len(*token) != 0 {
+		cfg.Token = *token
+	}
+	if len(*output) != 0 {
+		cfg.Output = *output
+	}
+	if cfg.Output != outputTable && cfg.Output != outputJSON {
+		_, _ = fmt.Fprintln(stderr, "Unknown output format:", cfg.Output)
+		return exitUsage
+	}
+
+	api, err := newClient(cfg)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "Invalid server URL:", err)
+		return exitUsage
+	}
+	cmd := command{
+		api: api,
+		out: &printer{w: stdout, json: cfg.Output == outputJSON},
+	}
+	err = cmd.dispatch(ctx, flags.Args())
+	return exitCode(err, stderr, flags.Usage)
+}
+
+func exitCode(err error, stderr io.Writer, printUsage func()) int {
+	if err == nil {
+		return exitOK
+	}
+
+	if errors.Is(err, errUsage) {
+		_, _ = fmt.Fprintln(stderr, err)
+		printUsage()
+		return exitUsage
+	}
+
+	_, _ = fmt.Fprintln(stderr, "Error:", err)
+	var apiErr *responseError
+	if errors.As(err, &apiErr) {
+		kind, ok := model.KindByCode(apiErr.code())
+		if ok {
+			return kind.Exit
+		}
+	}
+	return exitInternal
+}
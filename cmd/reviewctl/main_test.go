@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/api"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+func TestExitCodesCoverAPI(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData(api.Spec)
+	require.NoError(t, err)
+	code := spec.Components.Schemas["ErrorResponse"].Value.Properties["error"].Value.Properties["code"].Value
+	require.NotEmpty(t, code.Enum)
+
+	used := map[int]string{exitOK: "OK", exitUsage: "usage"}
+	for _, v := range code.Enum {
+		name, _ := v.(string)
+		kind, ok := model.KindByCode(name)
+		if !assert.True(t, ok, "%s has no exit code", name) {
+			continue
+		}
+		// Every error has its own code, so scripts can tell them apart.
+		assert.NotContains(t, used, kind.Exit, "%s shares exit code", name)
+		used[kind.Exit] = name
+	}
+	assert.Len(t, model.ErrorKinds(), len(code.Enum), "codes missing in API specification")
+}
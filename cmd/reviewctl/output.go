@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/LeonovDS/review-manager/client"
+)
+
+// Output modes.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// printer writes command results either as JSON or as aligned table.
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+func (p *printer) print(data any, header []string, rows [][]string) error {
+	if p.json {
+		encoder := json.NewEncoder(p.w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0) //nolint:mnd // padding between columns
+	_, err := fmt.Fprintln(tw, strings.Join(header, "\t"))
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		_, err = fmt.Fprintln(tw, strings.Join(row, "\t"))
+		if err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func (p *printer) team(team client.Team) error {
+	rows := make([][]string, 0, len(team.Members))
+	for _, m := range team.Members {
+		rows = append(rows, []string{team.TeamName, m.UserID, m.Username, strconv.FormatBool(m.IsActive)})
+	}
+	return p.print(team, []string{"TEAM", "USER_ID", "USERNAME", "ACTIVE"}, rows)
+}
+
+func (p *printer) user(user client.User) error {
+	team := "-"
+	if user.TeamName != nil {
+		team = *user.TeamName
+	}
+	return p.print(user, []string{"USER_ID", "USERNAME", "TEAM", "ACTIVE"}, [][]string{
+		{user.UserID, user.Username, team, strconv.FormatBool(user.IsActive)},
+	})
+}
+
+func (p *printer) pullRequests(data any, prs []client.PullRequest) error {
+	rows := make([][]string, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, []string{
+			pr.PullRequestID, pr.PullRequestName, pr.AuthorID, string(pr.Status),
+			strings.Join(pr.AssignedReviewers, ","), formatTime(pr.CreatedAt), formatTime(pr.MergedAt),
+		})
+	}
+	return p.print(data, []string{"ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS", "CREATED", "MERGED"}, rows)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,106 @@
+// Package app wires repositories into use cases shared by HTTP and gRPC APIs.
+package app
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/metrics"
+	"github.com/LeonovDS/review-manager/internal/oidc"
+	"github.com/LeonovDS/review-manager/internal/repository"
+	"github.com/LeonovDS/review-manager/internal/usecase/apikey"
+	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+	"github.com/LeonovDS/review-manager/internal/usecase/transfer"
+	"github.com/LeonovDS/review-manager/internal/usecase/user"
+)
+
+// Options configures use cases.
+type Options struct {
+	// Reviewers is number of reviewers assigned to new pull request.
+	Reviewers int
+	// Metrics receives assignment outcomes, may be nil.
+	Metrics *metrics.Metrics
+	// JWT verifies bearer tokens of engineers, may be nil. Without it only API keys are accepted.
+	JWT *oidc.Verifier
+}
+
+// UseCases holds every use case of service built on top of one database.
+type UseCases struct {
+	TeamAdd     *team.Adder
+	TeamGet     *team.Getter
+	TeamRename  *team.Renamer
+	TeamArchive *team.Archiver
+	TeamDelete  *team.Deleter
+	AddMembers  *team.MemberAdder
+	DelMembers  *team.MemberRemover
+	TeamSync    *team.Syncer
+
+	Reviews  *user.ReviewGetter
+	IsActive *user.StatusUpdater
+	Move     *user.TeamMover
+
+	Create   *pullrequest.Creator
+	Merge    *pullrequest.Merger
+	Reassign *pullrequest.Reassigner
+	Get      *pullrequest.Getter
+	List     *pullrequest.Lister
+
+	Import *transfer.Importer
+	Export *transfer.Exporter
+
+	KeyCreate *apikey.Creator
+	KeyRevoke *apikey.Revoker
+	KeyList   *apikey.Lister
+
+	// Tokens authenticates API keys and, if JWT is configured, tokens of engineers.
+	Tokens *apikey.Bearer
+}
+
+// New builds use cases from repositories of pool.
+func New(pool *pgxpool.Pool, opts Options) *UseCases {
+	tm := database.DBTransactionManager{Pool: pool}
+	teamRepo := repository.Team{Pool: pool}
+	userRepo := repository.User{Pool: pool}
+	prRepo := repository.PullRequest{Pool: pool}
+	eventRepo := repository.Events{Pool: pool}
+	keyRepo := repository.APIKey{Pool: pool}
+	releaser := pullrequest.ReviewReleaser{PR: &prRepo, User: &userRepo, Events: &eventRepo, Metrics: opts.Metrics}
+
+	tokens := &apikey.Bearer{Keys: &apikey.Authenticator{Key: &keyRepo}, Users: nil}
+	if opts.JWT != nil {
+		tokens.Users = &user.Authenticator{Tokens: opts.JWT, User: &userRepo}
+	}
+
+	return &UseCases{
+		TeamAdd:     &team.Adder{TX: &tm, Team: &teamRepo, User: &userRepo},
+		TeamGet:     &team.Getter{Team: &teamRepo, User: &userRepo},
+		TeamRename:  &team.Renamer{TX: &tm, Team: &teamRepo, User: &userRepo},
+		TeamArchive: &team.Archiver{TX: &tm, Team: &teamRepo, User: &userRepo, Reviews: &releaser},
+		TeamDelete:  &team.Deleter{TX: &tm, Team: &teamRepo, User: &userRepo, PR: &prRepo},
+		AddMembers:  &team.MemberAdder{TX: &tm, Team: &teamRepo, User: &userRepo},
+		DelMembers:  &team.MemberRemover{TX: &tm, Team: &teamRepo, User: &userRepo, Reviews: &releaser},
+		TeamSync:    &team.Syncer{TX: &tm, Team: &teamRepo, User: &userRepo, Reviews: &releaser},
+
+		Reviews:  &user.ReviewGetter{PR: &prRepo},
+		IsActive: &user.StatusUpdater{User: &userRepo},
+		Move:     &user.TeamMover{TX: &tm, Team: &teamRepo, User: &userRepo, Reviews: &releaser},
+
+		Create: &pullrequest.Creator{
+			TX: &tm, PR: &prRepo, User: &userRepo, Reviewers: opts.Reviewers, Metrics: opts.Metrics, Events: &eventRepo,
+		},
+		Merge:    &pullrequest.Merger{TX: &tm, PR: &prRepo, User: &userRepo, Events: &eventRepo},
+		Reassign: &pullrequest.Reassigner{TX: &tm, PR: &prRepo, User: &userRepo, Metrics: opts.Metrics, Events: &eventRepo},
+		Get:      &pullrequest.Getter{PR: &prRepo},
+		List:     &pullrequest.Lister{TX: &tm, PR: &prRepo},
+
+		Import: &transfer.Importer{TX: &tm, Team: &teamRepo, User: &userRepo, Reviews: &releaser},
+		Export: &transfer.Exporter{User: &userRepo, PR: &prRepo},
+
+		KeyCreate: &apikey.Creator{Key: &keyRepo},
+		KeyRevoke: &apikey.Revoker{Key: &keyRepo},
+		KeyList:   &apikey.Lister{Key: &keyRepo},
+
+		Tokens: tokens,
+	}
+}
@@ -0,0 +1,352 @@
+// Package config loads service configuration.
+//
+// Values are taken from defaults, then optional YAML file, then environment variables
+// and finally command line flags, so each source overrides the previous one.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Config is effective configuration of the service.
+type Config struct {
+	Server     Server     `yaml:"server"`
+	Database   Database   `yaml:"database"`
+	Log        Log        `yaml:"log"`
+	Tracing    Tracing    `yaml:"tracing"`
+	OIDC       OIDC       `yaml:"oidc"`
+	RateLimit  RateLimit  `yaml:"rate_limit"`
+	Features   Features   `yaml:"features"`
+	Assignment Assignment `yaml:"assignment"`
+}
+
+// Server configures HTTP server.
+type Server struct {
+	Addr              string        `yaml:"addr"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout"`
+	Contract          string        `yaml:"contract"`
+	IdempotencyTTL    time.Duration `yaml:"idempotency_ttl"`
+	Auth              bool          `yaml:"auth"`
+	// GRPCAddr is address of gRPC API, empty disables it.
+	GRPCAddr string `yaml:"grpc_addr"`
+}
+
+// Database configures connection pool and migrations.
+type Database struct {
+	URL             string        `yaml:"url"`
+	MigrationSrc    string        `yaml:"migration_src"`
+	Migrate         bool          `yaml:"migrate"`
+	MaxConns        int32         `yaml:"max_conns"`
+	MinConns        int32         `yaml:"min_conns"`
+	MaxConnLifetime time.Duration `yaml:"max_conn_lifetime"`
+	MaxConnIdleTime time.Duration `yaml:"max_conn_idle_time"`
+}
+
+// Log configures logger.
+type Log struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+// Tracing configures OpenTelemetry exporter.
+type Tracing struct {
+	Exporter    string  `yaml:"exporter"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// OIDC configures authentication of engineers with JWT issued by OpenID Connect provider.
+type OIDC struct {
+	// JWKS is path to key set file or its http(s) URL, empty disables JWT.
+	JWKS        string        `yaml:"jwks"`
+	JWKSRefresh time.Duration `yaml:"jwks_refresh"`
+	Issuer      string        `yaml:"issuer"`
+	Audience    string        `yaml:"audience"`
+	UserClaim   string        `yaml:"user_claim"`
+	RolesClaim  string        `yaml:"roles_claim"`
+	// OrgClaim holds organization of engineer, tokens without it belong to default organization.
+	OrgClaim string `yaml:"org_claim"`
+}
+
+// RateLimit configures limits that keep one client or burst of requests from exhausting database pool.
+type RateLimit struct {
+	// Rate is requests per second allowed to one client on every route, zero disables the limit.
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+	// Routes overrides limit for operations in form "METHOD /path", each has its own bucket.
+	Routes map[string]RouteLimit `yaml:"routes"`
+	// MaxInFlight is number of requests served at once, zero disables the limit.
+	MaxInFlight int `yaml:"max_in_flight"`
+}
+
+// RouteLimit is token bucket of one operation.
+type RouteLimit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// Features toggles optional parts of HTTP API.
+type Features struct {
+	Transfer bool `yaml:"transfer"`
+	Sync     bool `yaml:"sync"`
+	Metrics  bool `yaml:"metrics"`
+}
+
+// Assignment configures reviewer assignment.
+type Assignment struct {
+	Reviewers int `yaml:"reviewers"`
+}
+
+// Log formats.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Trace exporters.
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// OpenAPI contract validation modes.
+const (
+	ContractOff    = "off"
+	ContractLog    = "log"
+	ContractStrict = "strict"
+)
+
+// Default values.
+const (
+	defaultAddr              = ":8080"
+	defaultGRPCAddr          = ":9090"
+	defaultReadHeaderTimeout = 1 * time.Second
+	defaultReadTimeout       = 10 * time.Second
+	defaultWriteTimeout      = 30 * time.Second
+	defaultIdleTimeout       = 60 * time.Second
+	defaultShutdownTimeout   = 15 * time.Second
+	defaultIdempotencyTTL    = 24 * time.Hour
+	defaultJWKSRefresh       = time.Hour
+	defaultRate              = 20
+	defaultBurst             = 40
+	defaultMaxInFlight       = 4 * defaultMaxConns
+	defaultMigrationSrc      = "file://migrations/"
+	defaultMaxConns          = 10
+	defaultMaxConnLifetime   = time.Hour
+	defaultMaxConnIdleTime   = 30 * time.Minute
+	defaultReviewers         = 2
+	maxReviewers             = 10
+)
+
+const redacted = "[REDACTED]"
+
+// Default returns configuration used when nothing is overridden.
+// Database URL has no default and must be set explicitly.
+func Default() Config {
+	return Config{
+		Server: Server{
+			Addr:              defaultAddr,
+			ReadHeaderTimeout: defaultReadHeaderTimeout,
+			ReadTimeout:       defaultReadTimeout,
+			WriteTimeout:      defaultWriteTimeout,
+			IdleTimeout:       defaultIdleTimeout,
+			ShutdownTimeout:   defaultShutdownTimeout,
+			Contract:          ContractLog,
+			IdempotencyTTL:    defaultIdempotencyTTL,
+			Auth:              true,
+			GRPCAddr:          defaultGRPCAddr,
+		},
+		Database: Database{
+			URL:             "",
+			MigrationSrc:    defaultMigrationSrc,
+			Migrate:         true,
+			MaxConns:        defaultMaxConns,
+			MinConns:        0,
+			MaxConnLifetime: defaultMaxConnLifetime,
+			MaxConnIdleTime: defaultMaxConnIdleTime,
+		},
+		Log: Log{
+			Level:  "info",
+			Format: FormatText,
+		},
+		Tracing: Tracing{
+			Exporter:    ExporterNone,
+			SampleRatio: 1,
+		},
+		OIDC: OIDC{
+			JWKS:        "",
+			JWKSRefresh: defaultJWKSRefresh,
+			Issuer:      "",
+			Audience:    "",
+			UserClaim:   "sub",
+			RolesClaim:  "roles",
+			OrgClaim:    "org",
+		},
+		RateLimit: RateLimit{
+			Rate:        defaultRate,
+			Burst:       defaultBurst,
+			Routes:      map[string]RouteLimit{},
+			MaxInFlight: defaultMaxInFlight,
+		},
+		Features: Features{
+			Transfer: true,
+			Sync:     true,
+			Metrics:  true,
+		},
+		Assignment: Assignment{
+			Reviewers: defaultReviewers,
+		},
+	}
+}
+
+// Validate checks that configuration is usable and reports all problems at once.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(len(c.Server.Addr) != 0, "server.addr is required")
+	check(c.Server.GRPCAddr != c.Server.Addr, "server.grpc_addr must differ from server.addr")
+	check(c.Server.ReadHeaderTimeout >= 0, "server.read_header_timeout must not be negative")
+	check(c.Server.ReadTimeout >= 0, "server.read_timeout must not be negative")
+	check(c.Server.WriteTimeout >= 0, "server.write_timeout must not be negative")
+	check(c.Server.IdleTimeout >= 0, "server.idle_timeout must not be negative")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout must be positive")
+	check(slices.Contains([]string{ContractOff, ContractLog, ContractStrict}, c.Server.Contract),
+		"server.contract %q is unknown, expected off, log or strict", c.Server.Contract)
+	check(c.Server.IdempotencyTTL >= 0, "server.idempotency_ttl must not be negative")
+
+	check(len(c.Database.URL) != 0, "database.url is required (DB_URL or --db-url)")
+	check(len(c.Database.MigrationSrc) != 0, "database.migration_src is required")
+	check(c.Database.MaxConns > 0, "database.max_conns must be positive")
+	check(c.Database.MinConns >= 0 && c.Database.MinConns <= c.Database.MaxConns,
+		"database.min_conns must be between 0 and database.max_conns")
+	check(c.Database.MaxConnLifetime >= 0, "database.max_conn_lifetime must not be negative")
+	check(c.Database.MaxConnIdleTime >= 0, "database.max_conn_idle_time must not be negative")
+
+	var level slog.Level
+	check(level.UnmarshalText([]byte(c.Log.Level)) == nil,
+		"log.level %q is unknown, expected debug, info, warn or error", c.Log.Level)
+	check(slices.Contains([]string{FormatText, FormatJSON}, c.Log.Format),
+		"log.format %q is unknown, expected text or json", c.Log.Format)
+
+	check(slices.Contains([]string{ExporterNone, ExporterOTLP, ExporterStdout}, c.Tracing.Exporter),
+		"tracing.exporter %q is unknown, expected none, otlp or stdout", c.Tracing.Exporter)
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1,
+		"tracing.sample_ratio must be between 0 and 1")
+
+	if len(c.OIDC.JWKS) != 0 {
+		check(c.OIDC.JWKSRefresh > 0, "oidc.jwks_refresh must be positive")
+		check(len(c.OIDC.UserClaim) != 0, "oidc.user_claim is required")
+		check(len(c.OIDC.RolesClaim) != 0, "oidc.roles_claim is required")
+	}
+
+	check(c.RateLimit.Rate >= 0, "rate_limit.rate must not be negative")
+	check(c.RateLimit.Rate == 0 || c.RateLimit.Burst > 0, "rate_limit.burst must be positive")
+	for route, limit := range c.RateLimit.Routes {
+		check(strings.Contains(route, " /"), "rate_limit.routes %q must be in form \"METHOD /path\"", route)
+		check(limit.Rate > 0 && limit.Burst > 0, "rate_limit.routes %q must have positive rate and burst", route)
+	}
+	check(c.RateLimit.MaxInFlight >= 0, "rate_limit.max_in_flight must not be negative")
+
+	check(c.Assignment.Reviewers >= 0 && c.Assignment.Reviewers <= maxReviewers,
+		"assignment.reviewers must be between 0 and %d", maxReviewers)
+
+	return errors.Join(errs...)
+}
+
+// LogLevel returns parsed log level. Config must be validated.
+func (l Log) LogLevel() slog.Level {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(l.Level))
+	return level
+}
+
+// LogValue prints configuration with secrets redacted.
+func (c Config) LogValue() slog.Value { //nolint:gocritic // slog.LogValuer is used by value
+	return slog.GroupValue(
+		slog.Group("server",
+			slog.String("addr", c.Server.Addr),
+			slog.String("grpc_addr", c.Server.GRPCAddr),
+			slog.String("read_header_timeout", c.Server.ReadHeaderTimeout.String()),
+			slog.String("read_timeout", c.Server.ReadTimeout.String()),
+			slog.String("write_timeout", c.Server.WriteTimeout.String()),
+			slog.String("idle_timeout", c.Server.IdleTimeout.String()),
+			slog.String("shutdown_timeout", c.Server.ShutdownTimeout.String()),
+			slog.String("contract", c.Server.Contract),
+			slog.String("idempotency_ttl", c.Server.IdempotencyTTL.String()),
+			slog.Bool("auth", c.Server.Auth),
+		),
+		slog.Group("database",
+			slog.String("url", redactURL(c.Database.URL)),
+			slog.String("migration_src", c.Database.MigrationSrc),
+			slog.Bool("migrate", c.Database.Migrate),
+			slog.Int("max_conns", int(c.Database.MaxConns)),
+			slog.Int("min_conns", int(c.Database.MinConns)),
+			slog.String("max_conn_lifetime", c.Database.MaxConnLifetime.String()),
+			slog.String("max_conn_idle_time", c.Database.MaxConnIdleTime.String()),
+		),
+		slog.Group("log",
+			slog.String("level", c.Log.Level),
+			slog.String("format", c.Log.Format),
+		),
+		slog.Group("tracing",
+			slog.String("exporter", c.Tracing.Exporter),
+			slog.Float64("sample_ratio", c.Tracing.SampleRatio),
+		),
+		slog.Group("oidc",
+			slog.String("jwks", c.OIDC.JWKS),
+			slog.String("jwks_refresh", c.OIDC.JWKSRefresh.String()),
+			slog.String("issuer", c.OIDC.Issuer),
+			slog.String("audience", c.OIDC.Audience),
+			slog.String("user_claim", c.OIDC.UserClaim),
+			slog.String("roles_claim", c.OIDC.RolesClaim),
+			slog.String("org_claim", c.OIDC.OrgClaim),
+		),
+		slog.Group("rate_limit",
+			slog.Float64("rate", c.RateLimit.Rate),
+			slog.Int("burst", c.RateLimit.Burst),
+			slog.Int("routes", len(c.RateLimit.Routes)),
+			slog.Int("max_in_flight", c.RateLimit.MaxInFlight),
+		),
+		slog.Group("features",
+			slog.Bool("transfer", c.Features.Transfer),
+			slog.Bool("sync", c.Features.Sync),
+			slog.Bool("metrics", c.Features.Metrics),
+		),
+		slog.Group("assignment",
+			slog.Int("reviewers", c.Assignment.Reviewers),
+		),
+	)
+}
+
+// redactURL hides password in connection URL. Strings that are not URLs
+// (e.g. "host=... password=...") are hidden completely.
+func redactURL(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	u, err := url.Parse(s)
+	if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return redacted
+	}
+	q := u.Query()
+	if q.Has("password") {
+		q.Set("password", redacted)
+		u.RawQuery = q.Encode()
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,110 @@
+package config_test
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func envOf(vars map[string]string) config.LookupEnv {
+	return func(key string) (string, bool) {
+		v, ok := vars[key]
+		return v, ok
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	cfg, err := config.Load(fs, nil, envOf(map[string]string{"DB_URL": "postgres://db/review"}))
+
+	expected := config.Default()
+	expected.Database.URL = "postgres://db/review"
+	assert.NoError(t, err)
+	assert.Equal(t, expected, cfg)
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(`
+server:
+  addr: ":9000"
+  shutdown_timeout: 5s
+database:
+  url: postgres://file/review
+log:
+  level: debug
+rate_limit:
+  routes:
+    POST /team/add: {rate: 0.5, burst: 2}
+`), 0o600)
+	assert.NoError(t, err)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	cfg, err := config.Load(fs,
+		[]string{"--addr", ":9100", "--migrate=false", "--reviewers", "1", "up"},
+		envOf(map[string]string{
+			config.FileEnv: path,
+			"LISTEN_ADDR":  ":9050",
+			"LOG_FORMAT":   "json",
+			"AUTH_ENABLED": "false",
+			"RATE_LIMIT":   "5",
+			"GRPC_ADDR":    "",
+		}),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ":9100", cfg.Server.Addr)
+	assert.Empty(t, cfg.Server.GRPCAddr)
+	assert.Equal(t, 5*time.Second, cfg.Server.ShutdownTimeout)
+	assert.Equal(t, "postgres://file/review", cfg.Database.URL)
+	assert.False(t, cfg.Database.Migrate)
+	assert.False(t, cfg.Server.Auth)
+	assert.Equal(t, slog.LevelDebug, cfg.Log.LogLevel())
+	assert.Equal(t, config.FormatJSON, cfg.Log.Format)
+	assert.Equal(t, 1, cfg.Assignment.Reviewers)
+	assert.InDelta(t, 5, cfg.RateLimit.Rate, 0)
+	assert.Equal(t, map[string]config.RouteLimit{"POST /team/add": {Rate: 0.5, Burst: 2}}, cfg.RateLimit.Routes)
+	assert.Equal(t, []string{"up"}, fs.Args())
+}
+
+func TestLoadInvalid(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	args := []string{"--log-format", "xml", "--db-max-conns", "0", "--contract", "warn", "--idempotency-ttl", "-1h",
+		"--oidc-jwks", "jwks.json", "--oidc-user-claim", "", "--max-in-flight", "-1"}
+	_, err := config.Load(fs, args, envOf(nil))
+
+	assert.ErrorContains(t, err, "database.url is required")
+	assert.ErrorContains(t, err, "log.format")
+	assert.ErrorContains(t, err, "database.max_conns")
+	assert.ErrorContains(t, err, "server.contract")
+	assert.ErrorContains(t, err, "server.idempotency_ttl")
+	assert.ErrorContains(t, err, "oidc.user_claim")
+	assert.ErrorContains(t, err, "rate_limit.max_in_flight")
+}
+
+func TestLoadUnknownFileKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte("server:\n  port: 8080\n"), 0o600)
+	assert.NoError(t, err)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	_, err = config.Load(fs, []string{"--config", path}, envOf(map[string]string{"DB_URL": "postgres://db"}))
+
+	assert.ErrorContains(t, err, "field port not found")
+}
+
+func TestLogValueRedactsPassword(t *testing.T) {
+	cfg := config.Default()
+	cfg.Database.URL = "postgres://admin:secret@db:5432/review?sslmode=disable"
+
+	value := cfg.LogValue().String()
+
+	assert.NotContains(t, value, "secret")
+	assert.Contains(t, value, "postgres://admin:xxxxx@db:5432/review")
+}
@@ -0,0 +1,246 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv is environment variable with path to YAML configuration file.
+// It is overridden by --config flag.
+const FileEnv = "CONFIG_FILE"
+
+// LookupEnv returns environment variable and whether it is set, like os.LookupEnv.
+type LookupEnv func(key string) (string, bool)
+
+// setting binds one configuration value to its environment variable and flag.
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	bool  bool
+	set   func(c *Config, value string) error
+}
+
+// Load registers configuration flags in fs, parses args and builds configuration.
+// Command specific flags may be registered in fs before calling Load,
+// positional arguments are left in fs.Args().
+func Load(fs *flag.FlagSet, args []string, lookup LookupEnv) (Config, error) {
+	type override struct {
+		s     setting
+		value string
+	}
+
+	var overrides []override
+	for _, s := range settings() {
+		record := func(value string) error {
+			overrides = append(overrides, override{s: s, value: value})
+			return nil
+		}
+		if s.bool {
+			fs.BoolFunc(s.flag, s.usage+" (env "+s.env+")", record)
+		} else {
+			fs.Func(s.flag, s.usage+" (env "+s.env+")", record)
+		}
+	}
+	file := fs.String("config", "", "path to YAML configuration file (env "+FileEnv+")")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return Config{}, err
+	}
+
+	cfg := Default()
+
+	path := *file
+	if len(path) == 0 {
+		path, _ = lookup(FileEnv)
+	}
+	if len(path) != 0 {
+		err = loadFile(&cfg, path)
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
+	for _, s := range settings() {
+		value, ok := lookup(s.env)
+		if !ok {
+			continue
+		}
+		err = s.set(&cfg, value)
+		if err != nil {
+			return Config{}, fmt.Errorf("env %s: %w", s.env, err)
+		}
+	}
+
+	for _, o := range overrides {
+		err = o.s.set(&cfg, o.value)
+		if err != nil {
+			return Config{}, fmt.Errorf("flag --%s: %w", o.s.flag, err)
+		}
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadFile reads YAML file on top of cfg, so missing keys keep previous values.
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path) // #nosec G304 - path is given by operator
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	err = decoder.Decode(cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// settings lists values that can be set from environment and flags.
+func settings() []setting {
+	return []setting{
+		str("LISTEN_ADDR", "addr", "address to listen on",
+			func(c *Config) *string { return &c.Server.Addr }),
+		str("GRPC_ADDR", "grpc-addr", "address to listen on for gRPC API, empty disables it",
+			func(c *Config) *string { return &c.Server.GRPCAddr }),
+		duration("READ_HEADER_TIMEOUT", "read-header-timeout", "time to read request headers",
+			func(c *Config) *time.Duration { return &c.Server.ReadHeaderTimeout }),
+		duration("READ_TIMEOUT", "read-timeout", "time to read entire request",
+			func(c *Config) *time.Duration { return &c.Server.ReadTimeout }),
+		duration("WRITE_TIMEOUT", "write-timeout", "time to write response",
+			func(c *Config) *time.Duration { return &c.Server.WriteTimeout }),
+		duration("IDLE_TIMEOUT", "idle-timeout", "time to keep idle connection",
+			func(c *Config) *time.Duration { return &c.Server.IdleTimeout }),
+		duration("SHUTDOWN_TIMEOUT", "shutdown-timeout", "time to drain in-flight requests on shutdown",
+			func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout }),
+		str("CONTRACT_VALIDATION", "contract", "OpenAPI contract validation: off, log or strict",
+			func(c *Config) *string { return &c.Server.Contract }),
+		duration("IDEMPOTENCY_TTL", "idempotency-ttl", "time to keep responses for Idempotency-Key, 0 disables",
+			func(c *Config) *time.Duration { return &c.Server.IdempotencyTTL }),
+		boolean("AUTH_ENABLED", "auth", "require API keys for requests",
+			func(c *Config) *bool { return &c.Server.Auth }),
+
+		str("DB_URL", "db-url", "database connection URL",
+			func(c *Config) *string { return &c.Database.URL }),
+		str("MIGRATION_SRC", "migration-src", "migrations source URL",
+			func(c *Config) *string { return &c.Database.MigrationSrc }),
+		boolean("DB_MIGRATE", "migrate", "apply pending migrations before serving",
+			func(c *Config) *bool { return &c.Database.Migrate }),
+		integer("DB_MAX_CONNS", "db-max-conns", "maximum size of connection pool",
+			func(c *Config) *int32 { return &c.Database.MaxConns }),
+		integer("DB_MIN_CONNS", "db-min-conns", "minimum size of connection pool",
+			func(c *Config) *int32 { return &c.Database.MinConns }),
+		duration("DB_MAX_CONN_LIFETIME", "db-max-conn-lifetime", "time after which connection is closed",
+			func(c *Config) *time.Duration { return &c.Database.MaxConnLifetime }),
+		duration("DB_MAX_CONN_IDLE_TIME", "db-max-conn-idle-time", "time after which idle connection is closed",
+			func(c *Config) *time.Duration { return &c.Database.MaxConnIdleTime }),
+
+		str("LOG_LEVEL", "log-level", "log level: debug, info, warn or error",
+			func(c *Config) *string { return &c.Log.Level }),
+		str("LOG_FORMAT", "log-format", "log format: text or json",
+			func(c *Config) *string { return &c.Log.Format }),
+
+		str("TRACING_EXPORTER", "tracing-exporter", "trace exporter: none, otlp or stdout",
+			func(c *Config) *string { return &c.Tracing.Exporter }),
+		float("TRACING_SAMPLE_RATIO", "tracing-sample-ratio", "fraction of traces to sample",
+			func(c *Config) *float64 { return &c.Tracing.SampleRatio }),
+
+		str("OIDC_JWKS", "oidc-jwks", "JWKS file or URL to verify JWT of engineers, empty disables JWT",
+			func(c *Config) *string { return &c.OIDC.JWKS }),
+		duration("OIDC_JWKS_REFRESH", "oidc-jwks-refresh", "how often JWKS loaded by URL is refreshed",
+			func(c *Config) *time.Duration { return &c.OIDC.JWKSRefresh }),
+		str("OIDC_ISSUER", "oidc-issuer", "expected iss claim of JWT, empty skips check",
+			func(c *Config) *string { return &c.OIDC.Issuer }),
+		str("OIDC_AUDIENCE", "oidc-audience", "expected aud claim of JWT, empty skips check",
+			func(c *Config) *string { return &c.OIDC.Audience }),
+		str("OIDC_USER_CLAIM", "oidc-user-claim", "JWT claim with user_id",
+			func(c *Config) *string { return &c.OIDC.UserClaim }),
+		str("OIDC_ROLES_CLAIM", "oidc-roles-claim", "JWT claim with roles: admin, team_lead",
+			func(c *Config) *string { return &c.OIDC.RolesClaim }),
+		str("OIDC_ORG_CLAIM", "oidc-org-claim", "JWT claim with organization, empty puts everyone to default",
+			func(c *Config) *string { return &c.OIDC.OrgClaim }),
+
+		float("RATE_LIMIT", "rate-limit", "requests per second allowed to one client, 0 disables limit",
+			func(c *Config) *float64 { return &c.RateLimit.Rate }),
+		integer("RATE_LIMIT_BURST", "rate-limit-burst", "requests one client may send at once",
+			func(c *Config) *int { return &c.RateLimit.Burst }),
+		integer("MAX_IN_FLIGHT", "max-in-flight", "requests served at once, 0 disables limit",
+			func(c *Config) *int { return &c.RateLimit.MaxInFlight }),
+
+		boolean("FEATURE_TRANSFER", "feature-transfer", "enable CSV import and export endpoints",
+			func(c *Config) *bool { return &c.Features.Transfer }),
+		boolean("FEATURE_SYNC", "feature-sync", "enable /team/sync endpoint",
+			func(c *Config) *bool { return &c.Features.Sync }),
+		boolean("FEATURE_METRICS", "feature-metrics", "enable /metrics endpoint",
+			func(c *Config) *bool { return &c.Features.Metrics }),
+
+		integer("REVIEWER_COUNT", "reviewers", "number of reviewers assigned to new pull request",
+			func(c *Config) *int { return &c.Assignment.Reviewers }),
+	}
+}
+
+func str(env, name, usage string, field func(c *Config) *string) setting {
+	return setting{env: env, flag: name, usage: usage, bool: false, set: func(c *Config, value string) error {
+		*field(c) = value
+		return nil
+	}}
+}
+
+func duration(env, name, usage string, field func(c *Config) *time.Duration) setting {
+	return setting{env: env, flag: name, usage: usage, bool: false, set: func(c *Config, value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = d
+		return nil
+	}}
+}
+
+func boolean(env, name, usage string, field func(c *Config) *bool) setting {
+	return setting{env: env, flag: name, usage: usage, bool: true, set: func(c *Config, value string) error {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = b
+		return nil
+	}}
+}
+
+func float(env, name, usage string, field func(c *Config) *float64) setting {
+	return setting{env: env, flag: name, usage: usage, bool: false, set: func(c *Config, value string) error {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		*field(c) = f
+		return nil
+	}}
+}
+
+func integer[T int | int32](env, name, usage string, field func(c *Config) *T) setting {
+	return setting{env: env, flag: name, usage: usage, bool: false, set: func(c *Config, value string) error {
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return err
+		}
+		*field(c) = T(n)
+		return nil
+	}}
+}
@@ -0,0 +1,160 @@
+// Package contract checks HTTP requests and responses against OpenAPI specification of the service.
+package contract
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	"github.com/LeonovDS/review-manager/api"
+)
+
+// ErrViolation marks requests and responses that do not match specification.
+var ErrViolation = errors.New("contract violation")
+
+// Validator matches traffic with operations of specification.
+type Validator struct {
+	router routers.Router
+	opts   *openapi3filter.Options
+	strict bool
+}
+
+// New loads embedded specification.
+// Strict validator asks to reject mismatching traffic, otherwise it should only be reported.
+func New(strict bool) (*Validator, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(api.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("load OpenAPI specification: %w", err)
+	}
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Validator{
+		router: router,
+		opts: &openapi3filter.Options{ //nolint:exhaustruct // other options keep defaults
+			IncludeResponseStatus: true,
+			SkipSettingDefaults:   true,
+			AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+		},
+		strict: strict,
+	}, nil
+}
+
+// Strict reports whether mismatching traffic should be rejected.
+func (v *Validator) Strict() bool {
+	return v.strict
+}
+
+// Operation is request matched with operation of specification.
+type Operation struct {
+	input *openapi3filter.RequestValidationInput
+}
+
+// Request validates request and returns its operation.
+// Requests outside of specification get nil operation and no error, they are left to router.
+// Request body is read and replaced, so handler still can read it.
+func (v *Validator) Request(r *http.Request) (*Operation, error) {
+	route, params, err := v.router.FindRoute(r)
+	if err != nil {
+		return nil, nil //nolint:nilerr // unknown routes are not part of contract
+	}
+
+	input := &openapi3filter.RequestValidationInput{ //nolint:exhaustruct // other fields are for custom decoders
+		Request:    r,
+		PathParams: params,
+		Route:      route,
+		Options:    v.opts,
+	}
+	err = openapi3filter.ValidateRequest(r.Context(), input)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrViolation, describe(err))
+	}
+	return &Operation{input: input}, nil
+}
+
+// Response validates response to the operation. Nil operation accepts any response.
+func (o *Operation) Response(ctx context.Context, status int, header http.Header, body []byte) error {
+	if o == nil {
+		return nil
+	}
+
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: o.input,
+		Status:                 status,
+		Header:                 header,
+		Body:                   nil,
+		Options:                o.input.Options,
+	}
+	input.SetBodyBytes(bytes.Clone(body))
+	err := openapi3filter.ValidateResponse(ctx, input)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrViolation, describe(err))
+	}
+	return nil
+}
+
+// Streaming reports whether operation responds with stream of Server-Sent Events or CSV export.
+// Such responses never end or are too large to hold in memory, so they are not validated and must not be buffered.
+func (o *Operation) Streaming() bool {
+	if o == nil {
+		return false
+	}
+	ok := o.input.Route.Operation.Responses.Status(http.StatusOK)
+	if ok == nil || ok.Value == nil {
+		return false
+	}
+	return ok.Value.Content.Get("text/event-stream") != nil || ok.Value.Content.Get("text/csv") != nil
+}
+
+// describe shortens validation error to one line with path to invalid field,
+// errors of kin-openapi include whole schema otherwise.
+func describe(err error) string {
+	var prefix string
+	var reqErr *openapi3filter.RequestError
+	var respErr *openapi3filter.ResponseError
+	switch {
+	case errors.As(err, &reqErr) && reqErr.Parameter != nil:
+		prefix = fmt.Sprintf("parameter %q in %s", reqErr.Parameter.Name, reqErr.Parameter.In)
+	case errors.As(err, &reqErr) && reqErr.RequestBody != nil:
+		prefix = "request body"
+	case errors.As(err, &respErr):
+		prefix = "response"
+	}
+
+	var reason string
+	var schemaErr *openapi3.SchemaError
+	switch {
+	case errors.As(err, &schemaErr):
+		reason = schemaErr.Reason
+		if path := schemaErr.JSONPointer(); len(path) != 0 {
+			reason = fmt.Sprintf("field %q: %s", strings.Join(path, "."), reason)
+		}
+	case reqErr != nil && reqErr.Err != nil:
+		reason = reqErr.Err.Error()
+	case reqErr != nil:
+		reason = reqErr.Reason
+	case respErr != nil && respErr.Err != nil:
+		reason = respErr.Err.Error()
+	case respErr != nil:
+		reason = respErr.Reason
+	default:
+		reason = err.Error()
+	}
+
+	if len(prefix) == 0 {
+		return reason
+	}
+	return prefix + ": " + reason
+}
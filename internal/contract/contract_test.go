@@ -0,0 +1,150 @@
+package contract_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/internal/contract"
+)
+
+func newValidator(t *testing.T) *contract.Validator {
+	t.Helper()
+	v, err := contract.New(true)
+	require.NoError(t, err)
+	return v
+}
+
+func jsonRequest(method, target, body string) *http.Request {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	return r
+}
+
+func TestRequest_Valid(t *testing.T) {
+	v := newValidator(t)
+	body := `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`
+	r := jsonRequest(http.MethodPost, "/pullRequest/create", body)
+
+	op, err := v.Request(r)
+	require.NoError(t, err)
+	assert.NotNil(t, op)
+
+	read, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, body, string(read))
+}
+
+func TestRequest_MissingField(t *testing.T) {
+	v := newValidator(t)
+	r := jsonRequest(http.MethodPost, "/pullRequest/create", `{"pull_request_id":"pr-1","author_id":"u1"}`)
+
+	_, err := v.Request(r)
+	require.ErrorIs(t, err, contract.ErrViolation)
+	assert.Contains(t, err.Error(), "request body")
+	assert.Contains(t, err.Error(), "pull_request_name")
+}
+
+func TestRequest_InvalidParameter(t *testing.T) {
+	v := newValidator(t)
+	r := httptest.NewRequest(http.MethodGet, "/pullRequest/list?limit=1000", nil)
+
+	_, err := v.Request(r)
+	require.ErrorIs(t, err, contract.ErrViolation)
+	assert.Contains(t, err.Error(), `parameter "limit"`)
+}
+
+func TestRequest_YAMLRoster(t *testing.T) {
+	v := newValidator(t)
+	r := httptest.NewRequest(http.MethodPost, "/team/sync?dry_run=true",
+		strings.NewReader("teams:\n  - team_name: backend\n    members: []\n"))
+	r.Header.Set("Content-Type", "application/yaml")
+
+	_, err := v.Request(r)
+	require.NoError(t, err)
+}
+
+func TestRequest_UnknownRoute(t *testing.T) {
+	v := newValidator(t)
+
+	op, err := v.Request(httptest.NewRequest(http.MethodGet, "/unknown", nil))
+	require.NoError(t, err)
+	assert.Nil(t, op)
+	require.NoError(t, op.Response(t.Context(), http.StatusNotFound, http.Header{}, nil))
+}
+
+func TestResponse(t *testing.T) {
+	v := newValidator(t)
+	op, err := v.Request(httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+	require.NoError(t, err)
+	header := http.Header{"Content-Type": {"application/json"}}
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		valid  bool
+	}{
+		{"team", http.StatusOK, `{"team_name":"backend","members":[]}`, true},
+		{"null members", http.StatusOK, `{"team_name":"backend","members":null}`, false},
+		{"documented error", http.StatusNotFound, `{"error":{"code":"NOT_FOUND","message":"not found"}}`, true},
+		{"default error", http.StatusBadRequest, `{"error":{"code":"BAD_REQUEST","message":"bad request"}}`, true},
+		{"flat error", http.StatusNotFound, `{"code":"NOT_FOUND","message":"not found"}`, false},
+		{"unknown code", http.StatusConflict, `{"error":{"code":"OOPS","message":"oops"}}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := op.Response(t.Context(), tt.status, header, []byte(tt.body))
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, contract.ErrViolation)
+			}
+		})
+	}
+}
+
+func TestResponse_Metrics(t *testing.T) {
+	v := newValidator(t)
+	op, err := v.Request(httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.NoError(t, err)
+
+	header := http.Header{"Content-Type": {"text/plain; version=0.0.4; charset=utf-8; escaping=underscores"}}
+	require.NoError(t, op.Response(t.Context(), http.StatusOK, header, []byte("up 1\n")))
+}
+
+func TestOperation_Streaming(t *testing.T) {
+	v := newValidator(t)
+
+	op, err := v.Request(httptest.NewRequest(http.MethodGet, "/events/stream?user_id=u1", nil))
+	require.NoError(t, err)
+	assert.True(t, op.Streaming())
+
+	op, err = v.Request(httptest.NewRequest(http.MethodGet, "/export/users", nil))
+	require.NoError(t, err)
+	assert.True(t, op.Streaming())
+
+	op, err = v.Request(httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+	require.NoError(t, err)
+	assert.False(t, op.Streaming())
+	assert.False(t, (*contract.Operation)(nil).Streaming())
+}
+
+func TestSecurity(t *testing.T) {
+	security, err := contract.Security()
+	require.NoError(t, err)
+
+	assert.Equal(t, contract.Requirement{Public: true, Scopes: nil}, security["GET /health/live"])
+	assert.Equal(t, contract.Requirement{Public: false, Scopes: []string{"read"}}, security["GET /team/get"])
+	assert.Equal(t, contract.Requirement{Public: false, Scopes: []string{"write:pr"}},
+		security["POST /pullRequest/create"])
+	assert.Equal(t, contract.Requirement{Public: false, Scopes: []string{"admin:team"}}, security["POST /apiKey/create"])
+	for pattern, req := range security {
+		assert.True(t, req.Public || len(req.Scopes) != 0, "%s requires API key without scope", pattern)
+	}
+}
@@ -0,0 +1,56 @@
+package contract
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/LeonovDS/review-manager/api"
+)
+
+// SecurityScheme is name of API key scheme in specification.
+const SecurityScheme = "apiKey"
+
+// Requirement is what operation requires from API key.
+type Requirement struct {
+	// Public operations are served without API key.
+	Public bool
+	// Scopes must all be granted to API key.
+	Scopes []string
+}
+
+// Security returns requirements of every operation of embedded specification
+// in form "METHOD /path", the same as patterns of router.
+// Operation without own security uses top-level one, empty security makes operation public.
+func Security() (map[string]Requirement, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(api.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("load OpenAPI specification: %w", err)
+	}
+
+	security := map[string]Requirement{}
+	for path, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			requirements := doc.Security
+			if op.Security != nil {
+				requirements = *op.Security
+			}
+
+			pattern := strings.ToUpper(method) + " " + path
+			switch len(requirements) {
+			case 0:
+				security[pattern] = Requirement{Public: true, Scopes: nil}
+			case 1:
+				scopes, ok := requirements[0][SecurityScheme]
+				if !ok || len(requirements[0]) != 1 {
+					return nil, fmt.Errorf("%s: only %s security scheme is supported", pattern, SecurityScheme)
+				}
+				security[pattern] = Requirement{Public: false, Scopes: scopes}
+			default:
+				return nil, fmt.Errorf("%s: alternative security requirements are not supported", pattern)
+			}
+		}
+	}
+	return security, nil
+}
@@ -3,42 +3,40 @@ package database
 
 import (
 	"context"
-	"errors"
-	"log/slog"
-	"strings"
+	"time"
 
-	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/pgx" // database implementation for migrations
-	_ "github.com/golang-migrate/migrate/v4/source/file"  // source implementation for migrations
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// Connect applies migrations and creates connection pool to database.
-func Connect(ctx context.Context, connStr, migrationSrc string) (*pgxpool.Pool, error) {
-	err := migrateUp(connStr, migrationSrc)
-	if err != nil {
-		return nil, err
-	}
-
-	pool, err := pgxpool.New(ctx, connStr)
-	return pool, err
+// PoolLimits overrides connection pool settings. Zero values keep pgx defaults.
+type PoolLimits struct {
+	MaxConns        int32
+	MinConns        int32
+	MaxConnLifetime time.Duration
+	MaxConnIdleTime time.Duration
 }
 
-func migrateUp(connString, migrationsSrc string) error {
-	connString = strings.Replace(connString, "postgres", "pgx", 1)
-	m, err := migrate.New(migrationsSrc, connString)
+// Connect creates connection pool to database with query tracing.
+// Migrations are applied separately, see Migrator.
+func Connect(ctx context.Context, connStr string, limits PoolLimits) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(connStr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = m.Up()
-	if errors.Is(err, migrate.ErrNoChange) {
-		slog.Info("Database is up-to-date, no migrations applied")
-		return nil
-	} else if err != nil {
-		return err
+	if limits.MaxConns > 0 {
+		cfg.MaxConns = limits.MaxConns
+	}
+	if limits.MinConns > 0 {
+		cfg.MinConns = limits.MinConns
+	}
+	if limits.MaxConnLifetime > 0 {
+		cfg.MaxConnLifetime = limits.MaxConnLifetime
+	}
+	if limits.MaxConnIdleTime > 0 {
+		cfg.MaxConnIdleTime = limits.MaxConnIdleTime
 	}
+	cfg.ConnConfig.Tracer = queryTracer{}
 
-	slog.Info("Migrations applied successfully")
-	return nil
+	return pgxpool.NewWithConfig(ctx, cfg)
 }
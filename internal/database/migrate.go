@@ -0,0 +1,85 @@
+package database
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx" // database implementation for migrations
+	_ "github.com/golang-migrate/migrate/v4/source/file"  // source implementation for migrations
+)
+
+// Migrator manages database schema version.
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+// NewMigrator creates Migrator for database and migrations source.
+func NewMigrator(connStr, migrationSrc string) (*Migrator, error) {
+	connStr = strings.Replace(connStr, "postgres", "pgx", 1)
+	m, err := migrate.New(migrationSrc, connStr)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{m: m}, nil
+}
+
+// MigrateUp applies all pending migrations.
+func MigrateUp(connStr, migrationSrc string) error {
+	m, err := NewMigrator(connStr, migrationSrc)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = m.Close() }()
+
+	return m.Up()
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up() error {
+	return logResult(m.m.Up())
+}
+
+// Down rolls back one last migration.
+func (m *Migrator) Down() error {
+	return logResult(m.m.Steps(-1))
+}
+
+// To migrates up or down to given version.
+func (m *Migrator) To(version uint) error {
+	return logResult(m.m.Migrate(version))
+}
+
+// Version returns current version and whether last migration failed and left database dirty.
+// Version is 0 if no migrations were applied.
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Force sets version without running migrations, clearing dirty state after manual fix.
+func (m *Migrator) Force(version int) error {
+	return m.m.Force(version)
+}
+
+// Close releases source and database connections.
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+func logResult(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		slog.Info("Database is up-to-date, no migrations applied")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	slog.Info("Migrations applied successfully")
+	return nil
+}
@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -11,22 +13,64 @@ type TransactionManager interface {
 	WithTransaction(ctx context.Context, transaction func(context.Context) error) error
 }
 
+// Querier is common subset of pgxpool.Pool and pgx.Tx used by repositories.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+}
+
+type txKey struct{}
+
+type snapshotKey struct{}
+
+// Snapshot marks ctx so that transaction started by WithTransaction is read only with repeatable read
+// isolation: every query of it sees the same snapshot, e.g. page and total count of a list agree.
+func Snapshot(ctx context.Context) context.Context {
+	return context.WithValue(ctx, snapshotKey{}, true)
+}
+
+// Conn returns transaction started by WithTransaction for ctx, or pool outside of transaction.
+//
+//nolint:ireturn // callers should not depend on whether they are in transaction
+func Conn(ctx context.Context, pool *pgxpool.Pool) Querier {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	if ok {
+		return tx
+	}
+	return pool
+}
+
 // DBTransactionManager implements TransactionManager for pgx connection pool.
 type DBTransactionManager struct {
 	Pool *pgxpool.Pool
 }
 
 // WithTransaction wraps actions in postgres transaction.
+// Repositories use the transaction through context passed to actions.
+// Nested calls join already started transaction. See Snapshot for consistent reads.
 func (db *DBTransactionManager) WithTransaction(
 	ctx context.Context, transaction func(context.Context) error,
 ) error {
-	tx, err := db.Pool.Begin(ctx)
+	_, nested := ctx.Value(txKey{}).(pgx.Tx)
+	if nested {
+		return transaction(ctx)
+	}
+
+	var options pgx.TxOptions
+	if snapshot, _ := ctx.Value(snapshotKey{}).(bool); snapshot {
+		options = pgx.TxOptions{
+			IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly, DeferrableMode: "", BeginQuery: "", CommitQuery: "",
+		}
+	}
+	tx, err := db.Pool.BeginTx(ctx, options)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	err = transaction(ctx)
+	err = transaction(context.WithValue(ctx, txKey{}, tx))
 	if err != nil {
 		return err
 	}
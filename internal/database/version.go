@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SchemaVersion reads migration version applied to database without locking it, unlike Migrator.
+// Database without applied migrations has version 0.
+func SchemaVersion(ctx context.Context, pool *pgxpool.Pool) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := Conn(ctx, pool).QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).
+		Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return uint(version), dirty, nil //nolint:gosec // versions are positive
+}
+
+// CheckSchema fails if database schema is dirty or its version differs from expected.
+func CheckSchema(ctx context.Context, pool *pgxpool.Pool, expected uint) error {
+	version, dirty, err := SchemaVersion(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("schema version %d is dirty", version)
+	}
+	if version != expected {
+		return fmt.Errorf("schema version %d, expected %d", version, expected)
+	}
+	return nil
+}
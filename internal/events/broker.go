@@ -0,0 +1,122 @@
+// Package events delivers assignment events to subscribers of the same organization.
+package events
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// ErrLagged is reported to subscriber that did not keep up with events and was unsubscribed.
+var ErrLagged = errors.New("subscriber is too slow")
+
+// ErrClosed is reported to subscribers when broker is closed on shutdown.
+var ErrClosed = errors.New("server is shutting down")
+
+// subscriptionBuffer is number of events queued for subscriber before it is considered lagged.
+const subscriptionBuffer = 64
+
+// Broker fans out published events to subscribers. Publishing never blocks:
+// subscriber with full buffer is unsubscribed and has to subscribe again.
+// Nil broker drops events.
+type Broker struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// NewBroker creates broker without subscribers.
+func NewBroker() *Broker {
+	return &Broker{mu: sync.Mutex{}, subs: map[*Subscription]struct{}{}, closed: false}
+}
+
+// Subscription receives events of one organization until it is closed.
+type Subscription struct {
+	broker *Broker
+	org    string
+	events chan model.AssignmentEvent
+	// err is why broker ended subscription, guarded by broker.mu.
+	err error
+}
+
+// Subscribe starts delivering events of org. Subscription must be closed when it is not needed.
+func (b *Broker) Subscribe(org string) *Subscription {
+	s := &Subscription{
+		broker: b,
+		org:    org,
+		events: make(chan model.AssignmentEvent, subscriptionBuffer),
+		err:    nil,
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[s] = struct{}{}
+	if b.closed {
+		b.remove(s, ErrClosed)
+	}
+	return s
+}
+
+// Publish delivers events to subscribers of their organization.
+func (b *Broker) Publish(events ...model.AssignmentEvent) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for s := range b.subs {
+		for _, e := range events {
+			if e.Org != s.org {
+				continue
+			}
+			select {
+			case s.events <- e:
+				continue
+			default:
+			}
+			b.remove(s, ErrLagged)
+			break
+		}
+	}
+}
+
+// Close ends every subscription with ErrClosed, e.g. to finish streams on shutdown.
+// Later subscriptions are ended right away.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for s := range b.subs {
+		b.remove(s, ErrClosed)
+	}
+}
+
+// remove unsubscribes s because of err, b.mu must be held.
+func (b *Broker) remove(s *Subscription, err error) {
+	if _, ok := b.subs[s]; !ok {
+		return
+	}
+	delete(b.subs, s)
+	s.err = err
+	close(s.events)
+}
+
+// Events returns channel of events. It is closed when subscription is ended, see Err.
+func (s *Subscription) Events() <-chan model.AssignmentEvent {
+	return s.events
+}
+
+// Err returns why broker ended subscription: ErrLagged or ErrClosed.
+// It is nil while subscription is active or after it was closed by subscriber.
+func (s *Subscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	return s.err
+}
+
+// Close stops delivering events, it may be called several times.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s, nil)
+}
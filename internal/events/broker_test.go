@@ -0,0 +1,69 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+func event(org, prID string) model.AssignmentEvent {
+	return model.AssignmentEvent{Type: model.AssignmentAssigned, Org: org, PRID: prID} //nolint:exhaustruct
+}
+
+func TestBroker(t *testing.T) {
+	broker := events.NewBroker()
+	acme := broker.Subscribe("acme")
+	defer acme.Close()
+	other := broker.Subscribe("other")
+
+	broker.Publish(event("acme", "pr-1"), event("other", "pr-2"), event("acme", "pr-3"))
+
+	assert.Equal(t, "pr-1", (<-acme.Events()).PRID)
+	assert.Equal(t, "pr-3", (<-acme.Events()).PRID)
+	assert.Equal(t, "pr-2", (<-other.Events()).PRID)
+
+	// Closed subscription gets nothing and has no error.
+	other.Close()
+	other.Close()
+	broker.Publish(event("other", "pr-4"))
+	_, ok := <-other.Events()
+	assert.False(t, ok)
+	assert.NoError(t, other.Err())
+}
+
+func TestBroker_Lagged(t *testing.T) {
+	broker := events.NewBroker()
+	slow := broker.Subscribe("acme")
+	defer slow.Close()
+
+	for range 100 {
+		broker.Publish(event("acme", "pr-1"))
+	}
+
+	received := 0
+	for range slow.Events() {
+		received++
+	}
+	assert.Less(t, received, 100)
+	assert.ErrorIs(t, slow.Err(), events.ErrLagged)
+}
+
+func TestBroker_Close(t *testing.T) {
+	broker := events.NewBroker()
+	before := broker.Subscribe("acme")
+	broker.Close()
+	after := broker.Subscribe("acme")
+
+	for _, s := range []*events.Subscription{before, after} {
+		_, ok := <-s.Events()
+		assert.False(t, ok)
+		assert.ErrorIs(t, s.Err(), events.ErrClosed)
+	}
+
+	// Nil broker drops events.
+	var disabled *events.Broker
+	disabled.Publish(event("acme", "pr-1"))
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/apikey"
+)
+
+// APIKeyHandler provides handlers for API key management.
+type APIKeyHandler struct {
+	create *apikey.Creator
+	revoke *apikey.Revoker
+	list   *apikey.Lister
+}
+
+// NewAPIKeyHandler creates new APIKeyHandler.
+func NewAPIKeyHandler(create *apikey.Creator, revoke *apikey.Revoker, list *apikey.Lister) APIKeyHandler {
+	return APIKeyHandler{
+		create: create,
+		revoke: revoke,
+		list:   list,
+	}
+}
+
+type apiKeyResponse struct {
+	Key model.APIKey `json:"key"`
+}
+
+type apiKeyListResponse struct {
+	Keys []model.APIKey `json:"keys"`
+}
+
+// CreateAPIKey - POST /apiKey/create - issues API key, its token is returned only once.
+func (h *APIKeyHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateCreateAPIKey)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	scopes := make([]model.Scope, 0, len(req.Scopes))
+	for _, s := range req.Scopes {
+		scopes = append(scopes, model.Scope(s))
+	}
+	issued, err := h.create.Create(ctx, req.Name, scopes)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(issued)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+// RevokeAPIKey - POST /apiKey/revoke - revokes API key.
+func (h *APIKeyHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateRevokeAPIKey)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	key, err := h.revoke.Revoke(ctx, req.KeyID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(apiKeyResponse{Key: key})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+// ListAPIKeys - GET /apiKey/list - lists API keys without tokens.
+func (h *APIKeyHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.list.List(r.Context())
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(apiKeyListResponse{Keys: keys})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/LeonovDS/review-manager/internal/contract"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// authenticator finds actor by token sent in Authorization header.
+type authenticator interface {
+	Authenticate(ctx context.Context, token string) (model.Actor, error)
+}
+
+// withAuth requires bearer token with scopes listed in security of operation, see contract.Security.
+// Operations are looked up by r.Pattern, so it must run inside router. Operations missing from
+// security still require token. Owner of token is stored in context as actor of the request,
+// use cases check access to particular teams and pull requests.
+// Failed authentications are charged to remote address in limiter; once its bucket is empty,
+// requests from the address are rejected with TOO_MANY_REQUESTS before token is looked up.
+func withAuth(
+	tokens authenticator, security map[string]contract.Requirement, limiter *limit.Limiter,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			required := security[r.Pattern]
+			if required.Public {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+			if !strings.EqualFold(scheme, "Bearer") || len(token) == 0 {
+				unauthorized(w, fmt.Errorf("bearer token is required: %w", model.ErrUnauthorized))
+				return
+			}
+			failed := limit.FailedAuthClient(remoteHost(r))
+			if delay := limiter.Check(failed, ""); delay > 0 {
+				retryAfter(w, delay)
+				handleError(w, model.ErrRateLimited)
+				return
+			}
+			actor, err := tokens.Authenticate(r.Context(), token)
+			if errors.Is(err, model.ErrUnauthorized) {
+				limiter.Reserve(failed, "")
+				unauthorized(w, err)
+				return
+			}
+			if err != nil {
+				handleError(w, err)
+				return
+			}
+
+			for _, scope := range required.Scopes {
+				if !slices.Contains(actor.Scopes, model.Scope(scope)) {
+					handleError(w, fmt.Errorf("scope %s is required: %w", scope, model.ErrForbidden))
+					return
+				}
+			}
+
+			// Organization of actor scopes every query made while serving request.
+			ctx := model.WithOrg(model.WithActor(r.Context(), actor), actor.Org)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func unauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="review-manager"`)
+	handleError(w, err)
+}
@@ -0,0 +1,150 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/LeonovDS/review-manager/internal/contract"
+	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// tokenKeys authenticates tokens by lookup in map.
+type tokenKeys map[string]model.Actor
+
+func (k tokenKeys) Authenticate(_ context.Context, token string) (model.Actor, error) {
+	actor, ok := k[token]
+	if !ok {
+		return model.Actor{}, model.ErrUnauthorized
+	}
+	return actor, nil
+}
+
+func newAuthMux(actor *model.Actor, org *string) *http.ServeMux {
+	keys := tokenKeys{
+		"reader": {
+			ID: "k1", Name: "dashboard", Org: "payments", UserID: "", Role: "", Team: "",
+			Scopes: []model.Scope{model.ScopeRead},
+		},
+	}
+	security := map[string]contract.Requirement{
+		"GET /health/live":         {Public: true, Scopes: nil},
+		"GET /team/get":            {Public: false, Scopes: []string{"read"}},
+		"POST /pullRequest/create": {Public: false, Scopes: []string{"write:pr"}},
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*actor, _ = model.ActorFrom(r.Context())
+		*org = model.OrgFrom(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux := http.NewServeMux()
+	for _, pattern := range []string{"GET /health/live", "GET /team/get", "POST /pullRequest/create", "POST /team/add"} {
+		mux.Handle(pattern, handlers.WithAuth(keys, security, nil)(next))
+	}
+	return mux
+}
+
+func TestAuth(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		token  string
+		status int
+		actor  string
+		org    string
+	}{
+		{name: "public", method: http.MethodGet, target: "/health/live", status: http.StatusOK, org: model.DefaultOrg},
+		{name: "missing token", method: http.MethodGet, target: "/team/get", status: http.StatusUnauthorized},
+		{name: "unknown token", method: http.MethodGet, target: "/team/get", token: "other",
+			status: http.StatusUnauthorized},
+		{name: "granted scope", method: http.MethodGet, target: "/team/get", token: "reader",
+			status: http.StatusOK, actor: "k1", org: "payments"},
+		{name: "missing scope", method: http.MethodPost, target: "/pullRequest/create", token: "reader",
+			status: http.StatusForbidden},
+		{name: "operation without requirement", method: http.MethodPost, target: "/team/add",
+			status: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actor model.Actor
+			var org string
+			mux := newAuthMux(&actor, &org)
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if len(tt.token) != 0 {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rr := httptest.NewRecorder()
+
+			mux.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.status, rr.Code, rr.Body.String())
+			assert.Equal(t, tt.actor, actor.ID)
+			if tt.status == http.StatusOK {
+				assert.Equal(t, tt.org, org)
+			}
+			if tt.status == http.StatusUnauthorized {
+				assert.Contains(t, rr.Body.String(), `"code":"UNAUTHORIZED"`)
+				assert.NotEmpty(t, rr.Header().Get("WWW-Authenticate"))
+			}
+			if tt.status == http.StatusForbidden {
+				assert.Contains(t, rr.Body.String(), `"code":"FORBIDDEN"`)
+			}
+		})
+	}
+}
+
+// countingKeys counts tokens looked up, as each lookup costs a database query.
+type countingKeys struct {
+	tokenKeys
+	lookups int
+}
+
+func (k *countingKeys) Authenticate(ctx context.Context, token string) (model.Actor, error) {
+	k.lookups++
+	return k.tokenKeys.Authenticate(ctx, token)
+}
+
+func TestAuthLimitsFailures(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := limit.NewLimiter(limit.Rates{Default: limit.Rate{Rate: 1, Burst: 2}, Routes: nil},
+		func() time.Time { return now })
+	keys := &countingKeys{tokenKeys: tokenKeys{"reader": {
+		ID: "k1", Name: "dashboard", Org: "payments", UserID: "", Role: "", Team: "",
+		Scopes: []model.Scope{model.ScopeRead},
+	}}, lookups: 0}
+	security := map[string]contract.Requirement{"GET /team/get": {Public: false, Scopes: []string{"read"}}}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	mux := http.NewServeMux()
+	mux.Handle("GET /team/get", handlers.WithAuth(keys, security, limiter)(ok))
+	send := func(token, addr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+		req.RemoteAddr = addr
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		return rr
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, send("rm_guess1", "10.0.0.1:1000").Code)
+	assert.Equal(t, http.StatusUnauthorized, send("rm_guess2", "10.0.0.1:1001").Code)
+	rr := send("rm_guess3", "10.0.0.1:1002")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"code":"TOO_MANY_REQUESTS"`)
+	assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+	assert.Equal(t, 2, keys.lookups, "limited token must not be looked up")
+
+	assert.Equal(t, http.StatusOK, send("reader", "10.0.0.2:1000").Code, "other address is not limited")
+	assert.Equal(t, http.StatusOK, send("reader", "10.0.0.2:1001").Code)
+	assert.Equal(t, http.StatusOK, send("reader", "10.0.0.2:1002").Code, "successes are not charged")
+
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusOK, send("reader", "10.0.0.1:1003").Code)
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// maxBodySize limits size of JSON request bodies.
+const maxBodySize = 1 << 20
+
+// decodeJSON reads JSON request body and validates it with check.
+// Bodies of other content types or larger than maxBodySize, unknown fields and trailing data are rejected.
+func decodeJSON[T any](
+	w http.ResponseWriter,
+	r *http.Request,
+	check func(v *model.ValidationError, req T),
+) (T, error) {
+	var req T
+	err := checkContentType(r, "application/json")
+	if err != nil {
+		return req, err
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(&req)
+	if err != nil {
+		return req, decodeError(err)
+	}
+	_, err = decoder.Token()
+	if !errors.Is(err, io.EOF) {
+		return req, fmt.Errorf("unexpected data after JSON body: %w", model.ErrBadRequest)
+	}
+
+	var v model.ValidationError
+	check(&v, req)
+	return req, v.Err()
+}
+
+// checkContentType accepts requests with one of allowed media types, parameters like charset are ignored.
+func checkContentType(r *http.Request, allowed ...string) error {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || !slices.Contains(allowed, mediaType) {
+		return fmt.Errorf("content type must be %s: %w", strings.Join(allowed, " or "), model.ErrUnsupportedMediaType)
+	}
+	return nil
+}
+
+// bodyError reports body over size limit as ErrTooLarge, other errors are returned as is.
+func bodyError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return fmt.Errorf("%w: limit is %d bytes", model.ErrTooLarge, maxBytesErr.Limit)
+	}
+	return err
+}
+
+// decodeError turns error of JSON decoder into field error where it points to one.
+func decodeError(err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var v model.ValidationError
+	switch {
+	case errors.Is(err, io.EOF):
+		return fmt.Errorf("request body is empty: %w", model.ErrBadRequest)
+	case errors.As(err, &syntaxErr):
+		return fmt.Errorf("malformed JSON at offset %d: %w", syntaxErr.Offset, model.ErrBadRequest)
+	case errors.As(err, &typeErr):
+		v.Add(typeErr.Field, "must be %s", jsonType(typeErr.Type))
+		return v.Err()
+	}
+
+	// Decoder has no error type for unknown fields, only the message.
+	if name, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		field, unquoteErr := strconv.Unquote(name)
+		if unquoteErr == nil {
+			v.Add(field, "unknown field")
+			return v.Err()
+		}
+	}
+
+	err = bodyError(err)
+	if errors.Is(err, model.ErrTooLarge) {
+		return err
+	}
+	return fmt.Errorf("malformed JSON: %w", model.ErrBadRequest)
+}
+
+// jsonType names JSON type that Go type is decoded from.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() { //nolint:exhaustive // other kinds are not used in requests
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Pointer:
+		return jsonType(t.Elem())
+	default:
+		return "object"
+	}
+}
@@ -9,42 +9,32 @@ import (
 )
 
 type errorResponse struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    string             `json:"code"`
+	Message string             `json:"message"`
+	Fields  []model.FieldError `json:"fields,omitempty"`
 }
 
 func handleError(w http.ResponseWriter, err error) {
-	var data errorResponse
+	var data errorBody
 	data.Message = err.Error()
-	var code int
-	switch {
-	case errors.Is(err, model.ErrBadRequest):
-		data.Code = "BAD_REQUEST"
-		code = http.StatusBadRequest
-	case errors.Is(err, model.ErrTeamExists):
-		data.Code = "TEAM_EXISTS"
-		code = http.StatusBadRequest
-	case errors.Is(err, model.ErrPRExists):
-		data.Code = "PR_EXISTS"
-		code = http.StatusConflict
-	case errors.Is(err, model.ErrNotFound):
-		data.Code = "NOT_FOUND"
-		code = http.StatusNotFound
-	case errors.Is(err, model.ErrPRMerged):
-		data.Code = "PR_MERGED"
-		code = http.StatusConflict
-	case errors.Is(err, model.ErrNoCandidate):
-		data.Code = "NO_CANDIDATE"
-		code = http.StatusConflict
-	case errors.Is(err, model.ErrNotAssigned):
-		data.Code = "NOT_ASSIGNED"
-		code = http.StatusConflict
-	default:
-		data.Code = "INTERNAL_ERROR"
-		code = http.StatusInternalServerError
+	var validationErr *model.ValidationError
+	if errors.As(err, &validationErr) {
+		data.Fields = validationErr.Fields
 	}
+	var code int
+	data.Code, code = errorCode(err)
 
-	w.WriteHeader(code)
 	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(data)
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: data})
+}
+
+// errorCode maps error to code of response and HTTP status.
+func errorCode(err error) (string, int) {
+	kind := model.KindOf(err)
+	return kind.Code, kind.HTTPStatus
 }
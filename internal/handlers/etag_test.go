@@ -0,0 +1,75 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/LeonovDS/review-manager/internal/handlers"
+)
+
+func TestETagMatches(t *testing.T) {
+	const etag = `"abc"`
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{name: "No header", header: "", want: false},
+		{name: "Strong match", header: `"abc"`, want: true},
+		{name: "Weak match", header: `W/"abc"`, want: true},
+		{name: "Other tag", header: `"def"`, want: false},
+		{name: "Unquoted", header: `abc`, want: false},
+		{name: "Any", header: `*`, want: true},
+		{name: "List with match", header: `"def", W/"abc"`, want: true},
+		{name: "List without spaces", header: `"def","abc"`, want: true},
+		{name: "List without match", header: `"def", W/"ghi"`, want: false},
+		{name: "List with any", header: `"def", *`, want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, handlers.ETagMatches(test.header, etag))
+		})
+	}
+}
+
+func TestWriteWithETag(t *testing.T) {
+	data := map[string]string{"team_name": "backend"}
+	rr := httptest.NewRecorder()
+	handlers.WriteWithETag(rr, httptest.NewRequest(http.MethodGet, "/team/get", nil), data)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"team_name":"backend"}`, rr.Body.String())
+	etag := rr.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	tests := []struct {
+		name   string
+		header string
+		code   int
+	}{
+		{name: "Same tag", header: etag, code: http.StatusNotModified},
+		{name: "Weak tag", header: "W/" + etag, code: http.StatusNotModified},
+		{name: "Any", header: "*", code: http.StatusNotModified},
+		{name: "Tag in list", header: `"stale", ` + etag, code: http.StatusNotModified},
+		{name: "Stale tag", header: `"stale"`, code: http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+			req.Header.Set("If-None-Match", test.header)
+			rr := httptest.NewRecorder()
+			handlers.WriteWithETag(rr, req, data)
+			assert.Equal(t, test.code, rr.Code)
+			// Tag is the same for unchanged data, so client can revalidate again.
+			assert.Equal(t, etag, rr.Header().Get("ETag"))
+			if test.code == http.StatusNotModified {
+				assert.Empty(t, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/LeonovDS/review-manager/api"
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+const (
+	// keepAliveInterval is how often comment is sent to idle stream, so proxies don't close it.
+	keepAliveInterval = 15 * time.Second
+	// reconnectDelay is how long client waits before reconnecting to ended stream, in milliseconds.
+	reconnectDelay = 3000
+)
+
+// EventHandler contains dependencies for /events handlers.
+type EventHandler struct {
+	broker *events.Broker
+}
+
+// NewEventHandler creates new EventHandler.
+func NewEventHandler(broker *events.Broker) EventHandler {
+	return EventHandler{broker: broker}
+}
+
+// StreamEvents - GET /events/stream - streams assignment events of organization as Server-Sent Events.
+func (h *EventHandler) StreamEvents(w http.ResponseWriter, r *http.Request, params api.StreamEventsParams) {
+	ctx := r.Context()
+	var v model.ValidationError
+	if params.UserID != nil {
+		v.CheckID("user_id", *params.UserID)
+	}
+	if params.TeamName != nil {
+		v.CheckName("team_name", *params.TeamName, model.MaxNameLength)
+	}
+	if v.Err() != nil {
+		handleError(w, v.Err())
+		return
+	}
+
+	sub := h.broker.Subscribe(model.OrgFrom(ctx))
+	defer sub.Close()
+
+	// Stream lives longer than write timeout of server.
+	rc := http.NewResponseController(w)
+	err := rc.SetWriteDeadline(time.Time{})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to clear write deadline of event stream", "err", err)
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	_, err = fmt.Fprintf(w, "retry: %d\n\n", reconnectDelay)
+	if err == nil {
+		err = rc.Flush()
+	}
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for err == nil {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err = fmt.Fprint(w, ": keep-alive\n\n")
+		case e, ok := <-sub.Events():
+			if !ok {
+				slog.InfoContext(ctx, "Event stream ended", "err", sub.Err())
+				return
+			}
+			if !streamMatches(params, e) {
+				continue
+			}
+			err = writeEvent(w, e)
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+	}
+	slog.DebugContext(ctx, "Client of event stream is gone", "err", err)
+}
+
+// streamMatches reports whether event passes filters of /events/stream.
+func streamMatches(params api.StreamEventsParams, e model.AssignmentEvent) bool {
+	return (params.UserID == nil || e.Involves(*params.UserID)) &&
+		(params.TeamName == nil || e.TeamName == *params.TeamName)
+}
+
+// writeEvent writes event as message named by its type.
+func writeEvent(w http.ResponseWriter, e model.AssignmentEvent) error {
+	data, err := json.Marshal(eventResponse(e))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+	return err
+}
+
+func eventResponse(e model.AssignmentEvent) api.AssignmentEvent {
+	return api.AssignmentEvent{
+		Type:               api.AssignmentEventType(e.Type),
+		PullRequestID:      e.PRID,
+		AuthorID:           e.AuthorID,
+		TeamName:           e.TeamName,
+		ReviewerID:         optional(e.ReviewerID),
+		PreviousReviewerID: optional(e.PreviousID),
+		Time:               e.Time,
+	}
+}
+
+// optional returns nil for empty string, so it is omitted from response.
+func optional(s string) *string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
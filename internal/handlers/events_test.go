@@ -0,0 +1,95 @@
+package handlers_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/api"
+	"github.com/LeonovDS/review-manager/internal/contract"
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// newEventServer serves event stream behind contract validator and access log, like router does.
+func newEventServer(t *testing.T, broker *events.Broker) *httptest.Server {
+	t.Helper()
+	v, err := contract.New(true)
+	require.NoError(t, err)
+	server := &handlers.Server{EventHandler: handlers.NewEventHandler(broker)} //nolint:exhaustruct
+	srv := httptest.NewServer(handlers.WithAccessLog(handlers.WithContract(v, api.Handler(server))))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// readMessage reads lines of stream up to empty line that ends message.
+func readMessage(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var msg strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		if line == "\n" {
+			return msg.String()
+		}
+		msg.WriteString(line)
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	broker := events.NewBroker()
+	srv := newEventServer(t, broker)
+
+	resp, err := http.Get(srv.URL + "/events/stream?user_id=u2&team_name=backend") //nolint:noctx
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// Stream is subscribed once first message is flushed.
+	body := bufio.NewReader(resp.Body)
+	assert.Equal(t, "retry: 3000\n", readMessage(t, body))
+
+	at := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	broker.Publish(
+		model.AssignmentEvent{Type: model.AssignmentAssigned, Org: "acme", PRID: "pr-0", AuthorID: "u1",
+			TeamName: "backend", ReviewerID: "u2", PreviousID: "", Time: at},
+		model.AssignmentEvent{Type: model.AssignmentAssigned, Org: model.DefaultOrg, PRID: "pr-1", AuthorID: "u1",
+			TeamName: "backend", ReviewerID: "u3", PreviousID: "", Time: at},
+		model.AssignmentEvent{Type: model.AssignmentReassigned, Org: model.DefaultOrg, PRID: "pr-2", AuthorID: "u9",
+			TeamName: "frontend", ReviewerID: "u2", PreviousID: "u8", Time: at},
+		model.AssignmentEvent{Type: model.AssignmentReassigned, Org: model.DefaultOrg, PRID: "pr-1", AuthorID: "u1",
+			TeamName: "backend", ReviewerID: "u4", PreviousID: "u2", Time: at},
+		model.AssignmentEvent{Type: model.AssignmentMerged, Org: model.DefaultOrg, PRID: "pr-3", AuthorID: "u2",
+			TeamName: "backend", ReviewerID: "", PreviousID: "", Time: at},
+	)
+
+	// Events of other organizations, users and teams are skipped.
+	assert.Equal(t, "event: REASSIGNED\n"+
+		`data: {"author_id":"u1","previous_reviewer_id":"u2","pull_request_id":"pr-1","reviewer_id":"u4",`+
+		`"team_name":"backend","time":"2025-10-24T12:00:00Z","type":"REASSIGNED"}`+"\n", readMessage(t, body))
+	assert.Equal(t, "event: MERGED\n"+
+		`data: {"author_id":"u2","pull_request_id":"pr-3",`+
+		`"team_name":"backend","time":"2025-10-24T12:00:00Z","type":"MERGED"}`+"\n", readMessage(t, body))
+
+	// Stream ends when broker is closed on shutdown.
+	broker.Close()
+	_, err = body.ReadString('\n')
+	assert.Error(t, err)
+}
+
+func TestStreamEvents_InvalidFilter(t *testing.T) {
+	srv := newEventServer(t, events.NewBroker())
+
+	resp, err := http.Get(srv.URL + "/events/stream?user_id=bad%20id") //nolint:noctx
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
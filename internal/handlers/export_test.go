@@ -0,0 +1,17 @@
+package handlers
+
+// Exported for tests in handlers_test package.
+//
+//nolint:gochecknoglobals
+var (
+	WithRequestID   = withRequestID
+	WithAccessLog   = withAccessLog
+	WithRecovery    = withRecovery
+	WithContract    = withContract
+	WithIdempotency = withIdempotency
+	WithAuth        = withAuth
+	WithRateLimit   = withRateLimit
+	WithInFlight    = withInFlightLimit
+	ETagMatches     = etagMatches
+	WriteWithETag   = writeWithETag
+)
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/LeonovDS/review-manager/internal/health"
+)
+
+// HealthHandler serves liveness and readiness probes.
+type HealthHandler struct {
+	ready *health.Checker
+}
+
+// NewHealthHandler creates HealthHandler with readiness checks.
+func NewHealthHandler(ready *health.Checker) *HealthHandler {
+	return &HealthHandler{ready: ready}
+}
+
+// GetLiveness handles `GET /health/live`. It only shows that process is able to serve requests.
+func (h *HealthHandler) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, r, health.Report{Status: health.StatusOK, Checks: []health.Check{}})
+}
+
+// GetReadiness handles `GET /health/ready`. Responds 503 if any dependency is not ready.
+func (h *HealthHandler) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, r, h.ready.Check(r.Context()))
+}
+
+func writeHealth(w http.ResponseWriter, r *http.Request, report health.Report) {
+	code := http.StatusOK
+	if report.Status != health.StatusOK {
+		code = http.StatusServiceUnavailable
+		slog.WarnContext(r.Context(), "Service is not ready", slog.Any("checks", report.Checks))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(report)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+	}
+}
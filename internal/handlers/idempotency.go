@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// IdempotencyKeyHeader is header with key chosen by client, that makes retries of POST requests safe.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader marks responses replayed for retried requests.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// DefaultIdempotencyTTL is how long responses are kept for retries.
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// DefaultIdempotencyLease is how long request may hold its key without response.
+const DefaultIdempotencyLease = 30 * time.Second
+
+const maxIdempotencyKeyLength = 255
+
+// idempotencyStore keeps responses of requests sent with idempotency keys.
+type idempotencyStore interface {
+	Reserve(
+		ctx context.Context, key model.IdempotencyKey, hash []byte, ttl, lease time.Duration,
+	) (model.IdempotentRequest, bool, error)
+	Complete(ctx context.Context, key model.IdempotencyKey, resp model.StoredResponse) error
+	Release(ctx context.Context, key model.IdempotencyKey) error
+}
+
+// withIdempotency replays saved response when POST request is retried with the same Idempotency-Key.
+// Keys are scoped to operation and actor, so it must run inside router where r.Pattern is known,
+// after authentication.
+// Reusing key with different body is rejected. Responses with 5xx status are not saved,
+// so such requests are executed again on retry. Key of request that neither completed nor was
+// released within lease, e.g. because server crashed, is taken over by retry.
+func withIdempotency(store idempotencyStore, ttl, lease time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || len(header) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(header) > maxIdempotencyKeyLength {
+				var v model.ValidationError
+				v.Add(IdempotencyKeyHeader, "must be at most %d characters", maxIdempotencyKeyLength)
+				handleError(w, v.Err())
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportSize))
+			if err != nil {
+				err = bodyError(err)
+				if !errors.Is(err, model.ErrTooLarge) {
+					err = fmt.Errorf("read request body: %w", model.ErrBadRequest)
+				}
+				handleError(w, err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := sha256.Sum256(body)
+
+			ctx := r.Context()
+			key := model.IdempotencyKey{Key: header, Route: r.Pattern}
+			// Clients that chose the same key must not get responses of each other.
+			// User ids are unique only within organization, so it is part of the key too.
+			if actor, ok := model.ActorFrom(ctx); ok {
+				key.Key = actor.Org + ":" + actor.ID + ":" + header
+			}
+			saved, reserved, err := store.Reserve(ctx, key, hash[:], ttl, lease)
+			if err != nil {
+				handleError(w, err)
+				return
+			}
+			if !reserved {
+				replay(w, r, saved, hash[:])
+				return
+			}
+
+			bw := &bufferedWriter{header: http.Header{}, status: 0, body: bytes.Buffer{}}
+			completed := false
+			// Key is released if handler fails or panics, so client can retry.
+			defer func() {
+				if completed {
+					return
+				}
+				err := store.Release(context.WithoutCancel(ctx), key)
+				if err != nil {
+					slog.ErrorContext(ctx, "Failed to release idempotency key", "err", err)
+				}
+			}()
+			next.ServeHTTP(bw, r)
+
+			if bw.statusCode() < http.StatusInternalServerError {
+				err = store.Complete(context.WithoutCancel(ctx), key, model.StoredResponse{
+					Status:      bw.statusCode(),
+					ContentType: bw.header.Get("Content-Type"),
+					Body:        bw.body.Bytes(),
+				})
+				if err != nil {
+					slog.ErrorContext(ctx, "Failed to save response for idempotency key", "err", err)
+				}
+				completed = err == nil
+			}
+			bw.writeTo(w, r)
+		})
+	}
+}
+
+// replay writes response saved for the same key, if it was saved for the same request body.
+func replay(w http.ResponseWriter, r *http.Request, saved model.IdempotentRequest, hash []byte) {
+	switch {
+	case !bytes.Equal(saved.Hash, hash):
+		handleError(w, model.ErrIdempotencyKeyReused)
+	case saved.Response == nil:
+		handleError(w, model.ErrRequestInProgress)
+	default:
+		if len(saved.Response.ContentType) != 0 {
+			w.Header().Set("Content-Type", saved.Response.ContentType)
+		}
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(saved.Response.Status)
+		_, err := w.Write(saved.Response.Body)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		}
+	}
+}
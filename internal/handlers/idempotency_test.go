@@ -0,0 +1,156 @@
+package handlers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// memoryStore keeps idempotency keys in memory, ttl and lease are ignored.
+type memoryStore struct {
+	mu   sync.Mutex
+	keys map[model.IdempotencyKey]model.IdempotentRequest
+}
+
+func (s *memoryStore) Reserve(
+	_ context.Context, key model.IdempotencyKey, hash []byte, _, _ time.Duration,
+) (model.IdempotentRequest, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved, ok := s.keys[key]
+	if ok {
+		return saved, false, nil
+	}
+	s.keys[key] = model.IdempotentRequest{Hash: hash, Response: nil}
+	return model.IdempotentRequest{}, true, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, key model.IdempotencyKey, resp model.StoredResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := s.keys[key]
+	saved.Response = &resp
+	s.keys[key] = saved
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key model.IdempotencyKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.keys[key].Response == nil {
+		delete(s.keys, key)
+	}
+	return nil
+}
+
+// countingHandler echoes request body with status and counts calls.
+type countingHandler struct {
+	calls  int
+	status int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.calls++
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.status)
+	_, _ = w.Write(body)
+}
+
+func newIdempotentMux(store *memoryStore, next http.Handler) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("POST /pullRequest/reassign", handlers.WithIdempotency(store, time.Hour, time.Minute)(next))
+	return mux
+}
+
+func sendWithKey(mux http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", strings.NewReader(body))
+	if len(key) != 0 {
+		req.Header.Set(handlers.IdempotencyKeyHeader, key)
+	}
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	return rr
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{mu: sync.Mutex{}, keys: map[model.IdempotencyKey]model.IdempotentRequest{}}
+}
+
+func TestIdempotency_Replay(t *testing.T) {
+	next := &countingHandler{calls: 0, status: http.StatusOK}
+	mux := newIdempotentMux(newMemoryStore(), next)
+
+	first := sendWithKey(mux, "retry-1", `{"pull_request_id":"pr-1"}`)
+	second := sendWithKey(mux, "retry-1", `{"pull_request_id":"pr-1"}`)
+
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "application/json", second.Header().Get("Content-Type"))
+	assert.Empty(t, first.Header().Get(handlers.IdempotentReplayedHeader))
+	assert.Equal(t, "true", second.Header().Get(handlers.IdempotentReplayedHeader))
+}
+
+func TestIdempotency_ReusedKey(t *testing.T) {
+	next := &countingHandler{calls: 0, status: http.StatusOK}
+	mux := newIdempotentMux(newMemoryStore(), next)
+
+	sendWithKey(mux, "retry-1", `{"pull_request_id":"pr-1"}`)
+	rr := sendWithKey(mux, "retry-1", `{"pull_request_id":"pr-2"}`)
+
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"code":"IDEMPOTENCY_KEY_REUSED"`)
+}
+
+func TestIdempotency_InProgress(t *testing.T) {
+	store := newMemoryStore()
+	next := &countingHandler{calls: 0, status: http.StatusOK}
+	mux := newIdempotentMux(store, next)
+	body := `{"pull_request_id":"pr-1"}`
+	hash := sha256.Sum256([]byte(body))
+	// Original request is reserved, but has no response yet.
+	key := model.IdempotencyKey{Key: "retry-1", Route: "POST /pullRequest/reassign"}
+	store.keys[key] = model.IdempotentRequest{Hash: hash[:], Response: nil}
+
+	rr := sendWithKey(mux, "retry-1", body)
+
+	assert.Equal(t, 0, next.calls)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"code":"REQUEST_IN_PROGRESS"`)
+}
+
+func TestIdempotency_ServerErrorIsNotSaved(t *testing.T) {
+	next := &countingHandler{calls: 0, status: http.StatusInternalServerError}
+	mux := newIdempotentMux(newMemoryStore(), next)
+
+	sendWithKey(mux, "retry-1", `{"pull_request_id":"pr-1"}`)
+	next.status = http.StatusOK
+	rr := sendWithKey(mux, "retry-1", `{"pull_request_id":"pr-1"}`)
+
+	assert.Equal(t, 2, next.calls)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Get(handlers.IdempotentReplayedHeader))
+}
+
+func TestIdempotency_WithoutKey(t *testing.T) {
+	next := &countingHandler{calls: 0, status: http.StatusOK}
+	mux := newIdempotentMux(newMemoryStore(), next)
+
+	sendWithKey(mux, "", `{"pull_request_id":"pr-1"}`)
+	sendWithKey(mux, "", `{"pull_request_id":"pr-1"}`)
+
+	assert.Equal(t, 2, next.calls)
+}
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/contract"
+	"github.com/LeonovDS/review-manager/internal/logging"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// RequestIDHeader is header with request id, accepted from client and returned in response.
+const RequestIDHeader = "X-Request-ID"
+
+const (
+	maxRequestIDLength = 128
+	requestIDBytes     = 16
+)
+
+// withRequestID takes request id from client or generates new one,
+// stores it in context for loggers and returns it in response header.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(logging.WithRequestID(r.Context(), id)))
+	})
+}
+
+// validRequestID accepts short ids of printable ASCII, so they are safe to put into logs.
+func validRequestID(id string) bool {
+	if len(id) == 0 || len(id) > maxRequestIDLength {
+		return false
+	}
+	return !strings.ContainsFunc(id, func(c rune) bool { return c <= ' ' || c > '~' })
+}
+
+func newRequestID() string {
+	b := make([]byte, requestIDBytes)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// withAccessLog logs every request with status and latency.
+// Probes and metrics scrapes are logged at debug level to keep logs readable.
+func withAccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rw := &responseWriter{ResponseWriter: w, status: 0, size: 0}
+		next.ServeHTTP(rw, r)
+
+		level := slog.LevelInfo
+		switch {
+		case rw.status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case strings.HasPrefix(r.URL.Path, "/health/") || r.URL.Path == "/metrics":
+			level = slog.LevelDebug
+		}
+		slog.Log(r.Context(), level, "Request served",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("route", r.Pattern),
+			slog.Int("status", rw.statusCode()),
+			slog.Int("size", rw.size),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+	})
+}
+
+// withRecovery turns panic in handler into INTERNAL_ERROR response instead of dropped connection.
+func withRecovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &responseWriter{ResponseWriter: w, status: 0, size: 0}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler { //nolint:errorlint,err113 // sentinel is compared as documented
+				panic(v)
+			}
+			slog.ErrorContext(r.Context(), "Panic while serving request",
+				slog.Any("panic", v),
+				slog.String("stack", string(debug.Stack())),
+			)
+			if rw.status == 0 {
+				handleError(rw, errors.New("internal error"))
+			}
+		}()
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// withContract validates requests and responses against OpenAPI specification.
+// Strict validator rejects invalid requests with BAD_REQUEST and replaces invalid responses
+// with INTERNAL_ERROR, otherwise violations are only logged.
+// Responses are buffered, so nil validator disables the middleware completely.
+// Event streams and CSV exports are passed through after request is validated.
+func withContract(v *contract.Validator, next http.Handler) http.Handler {
+	if v == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op, err := v.Request(r)
+		if err != nil {
+			slog.WarnContext(r.Context(), "Request violates API contract", "err", err)
+			if v.Strict() {
+				handleError(w, fmt.Errorf("%w: %w", err, model.ErrBadRequest))
+				return
+			}
+		}
+		if op.Streaming() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		bw := &bufferedWriter{header: http.Header{}, status: 0, body: bytes.Buffer{}}
+		next.ServeHTTP(bw, r)
+
+		status := bw.statusCode()
+		// Routes disabled in router are still described in specification, their 404 is not checked.
+		if len(r.Pattern) == 0 {
+			op = nil
+		}
+		err = op.Response(r.Context(), status, bw.header, bw.body.Bytes())
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Response violates API contract",
+				slog.Any("err", err),
+				slog.String("route", r.Pattern),
+				slog.Int("status", status),
+			)
+			if v.Strict() {
+				handleError(w, errors.New("internal error"))
+				return
+			}
+		}
+
+		bw.writeTo(w, r)
+	})
+}
+
+// bufferedWriter holds response until it is validated.
+type bufferedWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// writeTo sends buffered response to client.
+func (w *bufferedWriter) writeTo(dst http.ResponseWriter, r *http.Request) {
+	maps.Copy(dst.Header(), w.header)
+	dst.WriteHeader(w.statusCode())
+	_, err := dst.Write(w.body.Bytes())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+	}
+}
+
+// responseWriter remembers status code and body size of response.
+type responseWriter struct {
+	http.ResponseWriter
+
+	status int
+	size   int
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach underlying writer, e.g. for flushing.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
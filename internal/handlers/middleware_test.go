@@ -0,0 +1,171 @@
+package handlers_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/contract"
+	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(logging.NewHandler(&buf, slog.LevelDebug, false)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func TestRequestIDIsPropagated(t *testing.T) {
+	var seen string
+	handler := handlers.WithRequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		seen = logging.RequestID(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+	req.Header.Set(handlers.RequestIDHeader, "ci-build-42")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, "ci-build-42", seen)
+	assert.Equal(t, "ci-build-42", rr.Header().Get(handlers.RequestIDHeader))
+}
+
+func TestRequestIDIsGeneratedForInvalidHeader(t *testing.T) {
+	handler := handlers.WithRequestID(http.NotFoundHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+	req.Header.Set(handlers.RequestIDHeader, "bad id\nwith newline")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	id := rr.Header().Get(handlers.RequestIDHeader)
+	assert.Len(t, id, 32)
+	assert.NotContains(t, id, " ")
+}
+
+func TestAccessLogWritesStatusAndRequestID(t *testing.T) {
+	logs := captureLogs(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /team/add", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	handler := handlers.WithRequestID(handlers.WithAccessLog(mux))
+
+	req := httptest.NewRequest(http.MethodPost, "/team/add", nil)
+	req.Header.Set(handlers.RequestIDHeader, "req-1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	line := logs.String()
+	assert.Contains(t, line, `msg="Request served"`)
+	assert.Contains(t, line, `route="POST /team/add"`)
+	assert.Contains(t, line, "status=201")
+	assert.Contains(t, line, "request_id=req-1")
+}
+
+func TestRecoveryReturnsInternalError(t *testing.T) {
+	logs := captureLogs(t)
+	handler := handlers.WithAccessLog(handlers.WithRecovery(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/pullRequest/get", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), "INTERNAL_ERROR")
+	assert.Contains(t, logs.String(), `msg="Panic while serving request" panic=boom`)
+	assert.Contains(t, logs.String(), `level=ERROR msg="Request served"`)
+}
+
+// teamGetWith serves /team/get with fixed JSON body behind contract middleware.
+func teamGetWith(t *testing.T, strict bool, status int, body string) (http.Handler, *bool) {
+	t.Helper()
+	v, err := contract.New(strict)
+	require.NoError(t, err)
+
+	called := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /team/get", func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	})
+	return handlers.WithContract(v, mux), &called
+}
+
+func TestContractPassesValidResponse(t *testing.T) {
+	handler, _ := teamGetWith(t, true, http.StatusOK, `{"team_name":"backend","members":[]}`)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"team_name":"backend","members":[]}`, rr.Body.String())
+}
+
+func TestContractRejectsInvalidRequest(t *testing.T) {
+	captureLogs(t)
+	handler, called := teamGetWith(t, true, http.StatusOK, `{"team_name":"backend","members":[]}`)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/team/get", nil))
+
+	assert.False(t, *called)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"code":"BAD_REQUEST"`)
+	assert.Contains(t, rr.Body.String(), `team_name`)
+}
+
+func TestContractReplacesInvalidResponse(t *testing.T) {
+	logs := captureLogs(t)
+	handler, _ := teamGetWith(t, true, http.StatusNotFound, `{"code":"NOT_FOUND","message":"not found"}`)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.JSONEq(t, `{"error":{"code":"INTERNAL_ERROR","message":"internal error"}}`, rr.Body.String())
+	assert.Contains(t, logs.String(), `msg="Response violates API contract"`)
+}
+
+func TestContractLogsInvalidResponse(t *testing.T) {
+	logs := captureLogs(t)
+	handler, _ := teamGetWith(t, false, http.StatusNotFound, `{"code":"NOT_FOUND","message":"not found"}`)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.JSONEq(t, `{"code":"NOT_FOUND","message":"not found"}`, rr.Body.String())
+	assert.Contains(t, logs.String(), `msg="Response violates API contract"`)
+}
+
+func TestContractStreamsExport(t *testing.T) {
+	v, err := contract.New(true)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /export/users", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("team_name,user_id,username,is_active\n"))
+		// Rows reach client while export is still running.
+		assert.Equal(t, "team_name,user_id,username,is_active\n", rr.Body.String())
+		_, _ = w.Write([]byte("backend,u1,Alice,true\n"))
+	})
+
+	handlers.WithContract(v, mux).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/export/users", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "team_name,user_id,username,is_active\nbackend,u1,Alice,true\n", rr.Body.String())
+}
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/LeonovDS/review-manager/api"
 	"github.com/LeonovDS/review-manager/internal/model"
 	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
 )
@@ -14,6 +15,8 @@ type PullRequestHandler struct {
 	create   *pullrequest.Creator
 	merge    *pullrequest.Merger
 	reassign *pullrequest.Reassigner
+	get      *pullrequest.Getter
+	list     *pullrequest.Lister
 }
 
 // NewPullRequestHandler creates new PullRequestHandler.
@@ -21,31 +24,32 @@ func NewPullRequestHandler(
 	create *pullrequest.Creator,
 	merge *pullrequest.Merger,
 	reassign *pullrequest.Reassigner,
+	get *pullrequest.Getter,
+	list *pullrequest.Lister,
 ) PullRequestHandler {
 	return PullRequestHandler{
 		create:   create,
 		merge:    merge,
 		reassign: reassign,
+		get:      get,
+		list:     list,
 	}
 }
 
-type createPRRequest struct {
-	ID     string `json:"pull_request_id"`
-	Name   string `json:"pull_request_name"`
-	Author string `json:"author_id"`
+type pullRequestResponse struct {
+	PR model.PullRequest `json:"pr"`
 }
 
-// Create - POST /pullRequest/create - creates a new pull request or returns error, if it exists.
-func (h *PullRequestHandler) Create(w http.ResponseWriter, r *http.Request) {
+// CreatePullRequest - POST /pullRequest/create - creates a new pull request or returns error, if it exists.
+func (h *PullRequestHandler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	var req createPRRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
+	req, err := decodeJSON(w, r, validateCreatePullRequest)
 	if err != nil {
-		handleError(w, model.ErrBadRequest)
+		handleError(w, err)
 		return
 	}
 
-	pr, err := h.create.Create(ctx, req.ID, req.Name, req.Author)
+	pr, err := h.create.Create(ctx, req.PullRequestID, req.PullRequestName, req.AuthorID)
 	if err != nil {
 		handleError(w, err)
 		return
@@ -53,63 +57,87 @@ func (h *PullRequestHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	err = json.NewEncoder(w).Encode(pr)
+	err = json.NewEncoder(w).Encode(pullRequestResponse{PR: pr})
 	if err != nil {
-		slog.Error("Failed to write response", "err", err)
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
 		return
 	}
 }
 
-type mergePRRequest struct {
-	ID string `json:"pull_request_id"`
+// MergePullRequest - POST /pullRequest/merge - merges a pull request (idempotent).
+func (h *PullRequestHandler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateMergePullRequest)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	pr, err := h.merge.Merge(ctx, req.PullRequestID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(pullRequestResponse{PR: pr})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
 }
 
-// Merge - POST /pullRequest/merge - merges a pull request (idempotent).
-func (h *PullRequestHandler) Merge(w http.ResponseWriter, r *http.Request) {
+// ReassignPullRequest - POST /pullRequest/reassign - reassigns a pull request to other team member if it is possible.
+func (h *PullRequestHandler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	var req mergePRRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
+	req, err := decodeJSON(w, r, validateReassignPullRequest)
 	if err != nil {
-		handleError(w, model.ErrBadRequest)
+		handleError(w, err)
 		return
 	}
 
-	pr, err := h.merge.Merge(ctx, req.ID)
+	res, err := h.reassign.Reassign(ctx, model.Reviewer{PRID: req.PullRequestID, UID: req.OldUserID})
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(w).Encode(pr)
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(res)
 	if err != nil {
-		slog.Error("Failed to write response", "err", err)
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
 		return
 	}
 }
 
-// Reassign - POST /pullRequest/reassign - reassigns a pull request to other team member if it is possible.
-func (h *PullRequestHandler) Reassign(w http.ResponseWriter, r *http.Request) {
+// GetPullRequest - GET /pullRequest/get - gets pull request with reviewers, supports conditional requests via ETag.
+func (h *PullRequestHandler) GetPullRequest(w http.ResponseWriter, r *http.Request, params api.GetPullRequestParams) {
 	ctx := r.Context()
-	var rev model.Reviewer
-	err := json.NewDecoder(r.Body).Decode(&rev)
+	pr, err := h.get.Get(ctx, params.PullRequestID)
 	if err != nil {
-		handleError(w, model.ErrBadRequest)
+		handleError(w, err)
 		return
 	}
 
-	rev, err = h.reassign.Reassign(ctx, rev)
+	writeWithETag(w, r, pr)
+}
+
+// ListPullRequests - GET /pullRequest/list - lists pull requests matching filters with cursor pagination.
+func (h *PullRequestHandler) ListPullRequests(w http.ResponseWriter, r *http.Request, params api.ListPullRequestsParams) {
+	ctx := r.Context()
+	page, err := h.list.List(ctx, listFilter(params), value(params.Cursor))
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(w).Encode(rev)
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(page)
 	if err != nil {
-		slog.Error("Failed to write response", "err", err)
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
 		return
 	}
 }
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/LeonovDS/review-manager/api"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// value dereferences optional parameter, returning zero value when it is missing.
+func value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// utc converts optional timestamp to UTC.
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
+
+// reviewQuery converts /users/getReview parameters to domain query.
+func reviewQuery(params api.GetUserReviewsParams) (model.ReviewQuery, error) {
+	order := value(params.Order)
+	include := value(params.Include)
+	if (order != "" && !order.Valid()) || (include != "" && !include.Valid()) {
+		return model.ReviewQuery{}, model.ErrBadRequest
+	}
+	return model.ReviewQuery{
+		Status:     string(value(params.Status)),
+		Limit:      value(params.Limit),
+		Cursor:     value(params.Cursor),
+		Descending: order == api.GetUserReviewsParamsOrderDesc,
+		Details:    include == api.GetUserReviewsParamsIncludeDetails,
+	}, nil
+}
+
+// listFilter converts /pullRequest/list parameters to domain filter.
+func listFilter(params api.ListPullRequestsParams) model.PullRequestFilter {
+	return model.PullRequestFilter{
+		Status:      string(value(params.Status)),
+		AuthorID:    value(params.AuthorID),
+		ReviewerID:  value(params.ReviewerID),
+		TeamName:    value(params.TeamName),
+		Name:        value(params.Name),
+		CreatedFrom: utc(params.CreatedFrom),
+		CreatedTo:   utc(params.CreatedTo),
+		MergedFrom:  utc(params.MergedFrom),
+		MergedTo:    utc(params.MergedTo),
+		After:       nil,
+		Descending:  false,
+		Limit:       value(params.Limit),
+	}
+}
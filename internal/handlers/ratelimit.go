@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// withRateLimit rejects requests of client that exceeded its limit with TOO_MANY_REQUESTS and Retry-After.
+// Clients are told apart by actor, so it must run inside authentication; requests without actor,
+// e.g. to public routes, are limited by remote address. Routes are looked up by r.Pattern.
+// Nil limiter disables the middleware.
+func withRateLimit(limiter *limit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			delay := limiter.Reserve(clientKey(r), r.Pattern)
+			if delay > 0 {
+				retryAfter(w, delay)
+				handleError(w, model.ErrRateLimited)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientKey identifies client of request for rate limiting.
+func clientKey(r *http.Request) string {
+	if actor, ok := model.ActorFrom(r.Context()); ok {
+		return "actor:" + actor.Org + ":" + actor.ID
+	}
+	return "ip:" + remoteHost(r)
+}
+
+// remoteHost returns address of client without port.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// withInFlightLimit rejects requests with OVERLOADED while every slot is taken,
+// so burst of slow requests queues in clients instead of waiting for database connections.
+// Probes, metrics and event streams, which stay open as long as client wants, are never rejected.
+// Nil slots disables the limit.
+func withInFlightLimit(slots *limit.Slots, next http.Handler) http.Handler {
+	if slots == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/health/") || r.URL.Path == "/metrics" || r.URL.Path == "/events/stream" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !slots.Acquire() {
+			retryAfter(w, time.Second)
+			handleError(w, model.ErrOverloaded)
+			return
+		}
+		defer slots.Release()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// retryAfter sets Retry-After header in whole seconds, rounded up.
+func retryAfter(w http.ResponseWriter, delay time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+}
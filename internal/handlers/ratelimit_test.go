@@ -0,0 +1,104 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+func newRateLimitMux(now func() time.Time) *http.ServeMux {
+	limiter := limit.NewLimiter(limit.Rates{
+		Default: limit.Rate{Rate: 1, Burst: 2},
+		Routes:  map[string]limit.Rate{"POST /team/add": {Rate: 0.1, Burst: 1}},
+	}, now)
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	mux := http.NewServeMux()
+	for _, pattern := range []string{"GET /team/get", "POST /team/add"} {
+		mux.Handle(pattern, handlers.WithRateLimit(limiter)(ok))
+	}
+	return mux
+}
+
+func sendAs(mux http.Handler, method, target, actor, addr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	req.RemoteAddr = addr
+	if len(actor) != 0 {
+		req = req.WithContext(model.WithActor(req.Context(), model.Actor{ID: actor, Org: model.DefaultOrg}))
+	}
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestRateLimit(t *testing.T) {
+	now := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	mux := newRateLimitMux(func() time.Time { return now })
+
+	// Burst is spent, then client waits for refill.
+	assert.Equal(t, http.StatusOK, sendAs(mux, http.MethodGet, "/team/get", "k1", "10.0.0.1:1000").Code)
+	assert.Equal(t, http.StatusOK, sendAs(mux, http.MethodGet, "/team/get", "k1", "10.0.0.1:1000").Code)
+	rr := sendAs(mux, http.MethodGet, "/team/get", "k1", "10.0.0.1:1000")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+	assert.Contains(t, rr.Body.String(), `"code":"TOO_MANY_REQUESTS"`)
+
+	// Other clients and routes with own limits have their own buckets.
+	assert.Equal(t, http.StatusOK, sendAs(mux, http.MethodGet, "/team/get", "k2", "10.0.0.1:1000").Code)
+	assert.Equal(t, http.StatusOK, sendAs(mux, http.MethodGet, "/team/get", "", "10.0.0.1:1000").Code)
+	assert.Equal(t, http.StatusOK, sendAs(mux, http.MethodPost, "/team/add", "k1", "10.0.0.1:1000").Code)
+	rr = sendAs(mux, http.MethodPost, "/team/add", "k1", "10.0.0.1:1000")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "10", rr.Header().Get("Retry-After"))
+
+	// Rejected requests don't take tokens, so client is served right after refill.
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusOK, sendAs(mux, http.MethodGet, "/team/get", "k1", "10.0.0.1:1000").Code)
+	assert.Equal(t, http.StatusTooManyRequests, sendAs(mux, http.MethodGet, "/team/get", "k1", "10.0.0.1:1000").Code)
+}
+
+func TestInFlightLimit(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	// Only pull request creation is slow.
+	handler := handlers.WithInFlight(limit.NewSlots(1), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/pullRequest/create" {
+			close(started)
+			<-release
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	done := make(chan int)
+	go func() {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil))
+		done <- rr.Code
+	}()
+	<-started
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/team/get", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+	assert.Contains(t, rr.Body.String(), `"code":"OVERLOADED"`)
+
+	// Probes are served even when limit is reached.
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health/live", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-done)
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/team/get", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
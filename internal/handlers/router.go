@@ -2,44 +2,169 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/LeonovDS/review-manager/api"
+	"github.com/LeonovDS/review-manager/internal/app"
+	"github.com/LeonovDS/review-manager/internal/contract"
 	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/health"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/metrics"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/oidc"
 	"github.com/LeonovDS/review-manager/internal/repository"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
-	"github.com/LeonovDS/review-manager/internal/usecase/team"
-	"github.com/LeonovDS/review-manager/internal/usecase/user"
+	"github.com/LeonovDS/review-manager/migrations"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Options configures optional parts of router.
+type Options struct {
+	// Reviewers is number of reviewers assigned to new pull request.
+	Reviewers int
+	// Transfer enables CSV import and export endpoints.
+	Transfer bool
+	// Sync enables /team/sync endpoint.
+	Sync bool
+	// Workers reports background workers state in readiness probe, may be nil.
+	Workers *health.Workers
+	// Metrics enables /metrics endpoint and instrumentation, may be nil.
+	// It must not be shared between routers.
+	Metrics *metrics.Metrics
+	// Contract validates traffic against OpenAPI specification, may be nil.
+	Contract *contract.Validator
+	// IdempotencyTTL is how long responses are kept for POST requests with Idempotency-Key,
+	// zero ignores the header.
+	IdempotencyTTL time.Duration
+	// IdempotencyLease is how long request may hold Idempotency-Key without response before retry
+	// takes it over. It should be at least write timeout of server, zero keeps key until IdempotencyTTL.
+	IdempotencyLease time.Duration
+	// Security lists scopes of API key required by operations, see contract.Security.
+	// Nil disables authentication.
+	Security map[string]contract.Requirement
+	// JWT verifies bearer tokens of engineers, may be nil. Without it only API keys are accepted.
+	JWT *oidc.Verifier
+	// RateLimiter limits requests of every client, may be nil. It is shared with gRPC server.
+	RateLimiter *limit.Limiter
+	// InFlight limits number of requests served at once, may be nil. It is shared with gRPC server.
+	InFlight *limit.Slots
+	// Events delivers assignment events committed by any replica to /events/stream.
+	// Nil disables the endpoint.
+	Events *events.Broker
+}
+
+// DefaultOptions returns options with every feature enabled.
+func DefaultOptions() Options {
+	return Options{
+		Reviewers:        pullrequest.DefaultReviewers,
+		Transfer:         true,
+		Sync:             true,
+		Workers:          nil,
+		Metrics:          nil,
+		Contract:         nil,
+		IdempotencyTTL:   DefaultIdempotencyTTL,
+		IdempotencyLease: DefaultIdempotencyLease,
+		Security:         nil,
+		JWT:              nil,
+		RateLimiter:      nil,
+		InFlight:         nil,
+		Events:           nil,
+	}
+}
+
 // NewRouter builds handlers from dependencies and combine them into router.
-func NewRouter(pool *pgxpool.Pool) *http.ServeMux {
-	tm := database.DBTransactionManager{Pool: pool}
-	teamRepo := repository.Team{Pool: pool}
-	userRepo := repository.User{Pool: pool}
-	prRepo := repository.PullRequest{Pool: pool}
+func NewRouter(pool *pgxpool.Pool, opts Options) http.Handler {
+	uc := app.New(pool, app.Options{Reviewers: opts.Reviewers, Metrics: opts.Metrics, JWT: opts.JWT})
 	teamHandler := NewTeamHandler(
-		&team.Adder{TX: &tm, Team: &teamRepo, User: &userRepo},
-		&team.Getter{Team: &teamRepo, User: &userRepo},
-	)
-	prHandler := NewPullRequestHandler(
-		&pullrequest.Creator{TX: &tm, PR: &prRepo, User: &userRepo},
-		&pullrequest.Merger{PR: &prRepo},
-		&pullrequest.Reassigner{TX: &tm, PR: &prRepo, User: &userRepo},
-	)
-	userHandler := NewUserHandler(
-		&user.ReviewGetter{PR: &prRepo},
-		&user.StatusUpdater{User: &userRepo},
+		uc.TeamAdd, uc.TeamGet, uc.TeamRename, uc.TeamArchive, uc.TeamDelete, uc.AddMembers, uc.DelMembers, uc.TeamSync,
 	)
+	prHandler := NewPullRequestHandler(uc.Create, uc.Merge, uc.Reassign, uc.Get, uc.List)
+	userHandler := NewUserHandler(uc.Reviews, uc.IsActive, uc.Move)
+	transferHandler := NewTransferHandler(uc.Import, uc.Export)
+	keyHandler := NewAPIKeyHandler(uc.KeyCreate, uc.KeyRevoke, uc.KeyList)
+	healthHandler := NewHealthHandler(readinessChecks(pool, opts.Workers))
+
+	server := Server{
+		TeamHandler:        teamHandler,
+		PullRequestHandler: prHandler,
+		UserHandler:        userHandler,
+		TransferHandler:    transferHandler,
+		APIKeyHandler:      keyHandler,
+		EventHandler:       NewEventHandler(opts.Events),
+		HealthHandler:      healthHandler,
+		metrics:            http.NotFoundHandler(),
+	}
+	var disabled []string
+	if !opts.Sync {
+		disabled = append(disabled, "POST /team/sync")
+	}
+	if !opts.Transfer {
+		disabled = append(disabled, "POST /import/users", "GET /export/users", "GET /export/pullRequests")
+	}
+	if opts.Events == nil {
+		disabled = append(disabled, "GET /events/stream")
+	}
+	if opts.Metrics != nil {
+		opts.Metrics.RegisterPool(pool)
+		opts.Metrics.RegisterOpenReviews((&repository.PullRequest{Pool: pool}).CountOpenReviews)
+		server.metrics = opts.Metrics.Handler()
+	} else {
+		disabled = append(disabled, "GET /metrics")
+	}
+
+	var middlewares []api.MiddlewareFunc
+	if opts.IdempotencyTTL > 0 {
+		middlewares = append(middlewares,
+			withIdempotency(&repository.Idempotency{Pool: pool}, opts.IdempotencyTTL, opts.IdempotencyLease))
+	}
+	if opts.RateLimiter != nil {
+		middlewares = append(middlewares, withRateLimit(opts.RateLimiter))
+	}
+	// Last middleware is outermost, so requests are authenticated before anything else.
+	// Authentication itself is limited by failed attempts of remote address.
+	if opts.Security != nil {
+		middlewares = append(middlewares, withAuth(uc.Tokens, opts.Security, opts.RateLimiter))
+	}
 
 	mux := http.NewServeMux()
-	mux.HandleFunc("POST /team/add", teamHandler.Add)
-	mux.HandleFunc("GET /team/get", teamHandler.Get)
-	mux.HandleFunc("POST /pullRequest/create", prHandler.Create)
-	mux.HandleFunc("POST /pullRequest/merge", prHandler.Merge)
-	mux.HandleFunc("POST /pullRequest/reassign", prHandler.Reassign)
-	mux.HandleFunc("GET /users/getReview", userHandler.GetReview)
-	mux.HandleFunc("POST /users/setIsActive", userHandler.SetIsActive)
-
-	return mux
+	api.HandlerWithOptions(&server, api.StdHTTPServerOptions{
+		BaseURL:     "",
+		BaseRouter:  routes{ServeMux: mux, disabled: disabled},
+		Middlewares: middlewares,
+		// Parameters that can't be bound to their types are reported like other malformed requests.
+		ErrorHandlerFunc: func(w http.ResponseWriter, _ *http.Request, err error) {
+			handleError(w, fmt.Errorf("%w: %w", err, model.ErrBadRequest))
+		},
+	})
+
+	// Middlewares after request id share request with mux, so they see matched route in r.Pattern.
+	var handler http.Handler = withContract(opts.Contract, withRecovery(mux))
+	handler = withInFlightLimit(opts.InFlight, handler)
+	handler = withAccessLog(handler)
+	handler = opts.Metrics.Middleware(handler)
+	handler = telemetry.Middleware(handler)
+	return withRequestID(handler)
+}
+
+// readinessChecks checks database connection, schema version and background workers.
+func readinessChecks(pool *pgxpool.Pool, workers *health.Workers) *health.Checker {
+	var checker health.Checker
+	checker.Add("database", pool.Ping)
+	checker.Add("migrations", func(ctx context.Context) error {
+		expected, err := migrations.Latest()
+		if err != nil {
+			return err
+		}
+		return database.CheckSchema(ctx, pool, expected)
+	})
+	if workers != nil {
+		checker.Add("workers", workers.Check)
+	}
+	return &checker
 }
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/LeonovDS/review-manager/api"
+)
+
+// Server implements operations of OpenAPI specification by combining handlers of every resource.
+type Server struct {
+	TeamHandler
+	PullRequestHandler
+	UserHandler
+	TransferHandler
+	APIKeyHandler
+	EventHandler
+	*HealthHandler
+
+	metrics http.Handler
+}
+
+var _ api.ServerInterface = (*Server)(nil)
+
+// GetMetrics - GET /metrics - exposes Prometheus metrics.
+func (s *Server) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	s.metrics.ServeHTTP(w, r)
+}
+
+// routes registers generated operations on mux, skipping patterns of disabled features.
+type routes struct {
+	*http.ServeMux
+
+	disabled []string
+}
+
+// HandleFunc registers handler unless pattern belongs to disabled feature.
+func (m routes) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	if slices.Contains(m.disabled, pattern) {
+		return
+	}
+	m.ServeMux.HandleFunc(pattern, handler)
+}
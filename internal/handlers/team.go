@@ -2,69 +2,262 @@ package handlers
 
 import (
 	"encoding/json"
+	"io"
 	"log/slog"
 	"net/http"
 
+	"github.com/LeonovDS/review-manager/api"
 	"github.com/LeonovDS/review-manager/internal/model"
 	"github.com/LeonovDS/review-manager/internal/usecase/team"
 )
 
 // TeamHandler contains dependencies for /team handlers.
 type TeamHandler struct {
-	add *team.Adder
-	get *team.Getter
+	add     *team.Adder
+	get     *team.Getter
+	rename  *team.Renamer
+	archive *team.Archiver
+	del     *team.Deleter
+	members *team.MemberAdder
+	remove  *team.MemberRemover
+	sync    *team.Syncer
 }
 
 // NewTeamHandler creates new TeamHandler.
-func NewTeamHandler(add *team.Adder, get *team.Getter) TeamHandler {
+func NewTeamHandler(
+	add *team.Adder,
+	get *team.Getter,
+	rename *team.Renamer,
+	archive *team.Archiver,
+	del *team.Deleter,
+	members *team.MemberAdder,
+	remove *team.MemberRemover,
+	sync *team.Syncer,
+) TeamHandler {
 	return TeamHandler{
-		add: add,
-		get: get,
+		add:     add,
+		get:     get,
+		rename:  rename,
+		archive: archive,
+		del:     del,
+		members: members,
+		remove:  remove,
+		sync:    sync,
 	}
 }
 
-// Add - POST /team/add - adds team and users.
-func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
+type teamResponse struct {
+	Team model.Team `json:"team"`
+}
+
+// AddTeam - POST /team/add - adds team and users.
+func (h *TeamHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	var team model.Team
-	err := json.NewDecoder(r.Body).Decode(&team)
-	slog.Info("/team/add", "team", team)
+	req, err := decodeJSON(w, r, validateTeam)
 	if err != nil {
-		handleError(w, model.ErrBadRequest)
+		handleError(w, err)
 		return
 	}
 
-	team, err = h.add.Add(ctx, team)
+	team, err := h.add.Add(ctx, teamFromAPI(req))
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(teamResponse{Team: team})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+// GetTeam - GET /team/get - get team info.
+func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request, params api.GetTeamParams) {
+	ctx := r.Context()
+	team, err := h.get.Get(ctx, params.TeamName)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(team)
 	if err != nil {
-		slog.Error("Failed to write response", "err", err)
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
 		return
 	}
 }
 
-// Get - GET /team/get - get team info.
-func (h *TeamHandler) Get(w http.ResponseWriter, r *http.Request) {
+// RenameTeam - POST /team/rename - renames team, members follow the new name.
+func (h *TeamHandler) RenameTeam(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	name := r.URL.Query().Get("team_name")
+	req, err := decodeJSON(w, r, validateRenameTeam)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
 
-	team, err := h.get.Get(ctx, name)
+	team, err := h.rename.Rename(ctx, req.TeamName, req.NewTeamName)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeTeam(w, r, team)
+}
+
+// ArchiveTeam - POST /team/archive - archives team, deactivates its members and removes them from reviews.
+func (h *TeamHandler) ArchiveTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateArchiveTeam)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	team, err := h.archive.Archive(ctx, req.TeamName)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(team)
 	if err != nil {
-		slog.Error("Failed to write response", "err", err)
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+// DeleteTeam - POST /team/delete - deletes team, optionally moving members to target team.
+func (h *TeamHandler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateDeleteTeam)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	team, err := h.del.Delete(ctx, req.TeamName, value(req.TargetTeamName))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeTeam(w, r, team)
+}
+
+// AddTeamMembers - POST /team/addMembers - adds new members to existing team.
+func (h *TeamHandler) AddTeamMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateTeam)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	team, err := h.members.AddMembers(ctx, teamFromAPI(req))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeTeam(w, r, team)
+}
+
+// RemoveTeamMembers - POST /team/removeMembers - removes members from team.
+func (h *TeamHandler) RemoveTeamMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateRemoveTeamMembers)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	change, err := h.remove.RemoveMembers(ctx, req.TeamName, req.UserIds, value(req.ReassignReviews))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(change)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
 		return
 	}
 }
+
+// maxRosterSize limits size of roster accepted by /team/sync.
+const maxRosterSize = 1 << 20
+
+// SyncTeams - POST /team/sync - brings teams to state described by roster in JSON or YAML.
+func (h *TeamHandler) SyncTeams(w http.ResponseWriter, r *http.Request, params api.SyncTeamsParams) {
+	ctx := r.Context()
+	err := checkContentType(r, "application/json", "application/yaml")
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRosterSize))
+	if err != nil {
+		handleError(w, bodyError(err))
+		return
+	}
+
+	roster, err := team.ParseRoster(data)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	var v model.ValidationError
+	v.CheckRoster(roster)
+	if v.Err() != nil {
+		handleError(w, v.Err())
+		return
+	}
+
+	plan, err := h.sync.Sync(ctx, roster, value(params.DryRun))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(plan)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+func writeTeam(w http.ResponseWriter, r *http.Request, team model.Team) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(team)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+// teamFromAPI converts team from request body to domain model.
+func teamFromAPI(t api.Team) model.Team {
+	members := make([]model.User, 0, len(t.Members))
+	for _, m := range t.Members {
+		members = append(members, model.User{
+			UserID:   m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+			TeamName: "",
+		})
+	}
+	return model.Team{TeamName: t.TeamName, Members: members, Archived: false}
+}
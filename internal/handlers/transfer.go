@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/LeonovDS/review-manager/internal/usecase/transfer"
+)
+
+// TransferHandler contains dependencies for CSV import and export handlers.
+type TransferHandler struct {
+	importer *transfer.Importer
+	exporter *transfer.Exporter
+}
+
+// NewTransferHandler creates new TransferHandler.
+func NewTransferHandler(importer *transfer.Importer, exporter *transfer.Exporter) TransferHandler {
+	return TransferHandler{
+		importer: importer,
+		exporter: exporter,
+	}
+}
+
+// maxImportSize limits size of CSV accepted by /import handlers.
+const maxImportSize = 10 << 20
+
+// ImportUsers - POST /import/users - creates or updates users from CSV.
+func (h *TransferHandler) ImportUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	err := checkContentType(r, "text/csv")
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	body := http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	res, err := h.importer.ImportUsers(ctx, body)
+	if err != nil {
+		handleError(w, bodyError(err))
+		return
+	}
+
+	code := http.StatusOK
+	if len(res.Errors) != 0 {
+		code = http.StatusBadRequest
+	}
+	for i := range res.Errors {
+		res.Errors[i].Code, _ = errorCode(res.Errors[i].Err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err = json.NewEncoder(w).Encode(res)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+// ExportUsers - GET /export/users - streams all users as CSV.
+func (h *TransferHandler) ExportUsers(w http.ResponseWriter, r *http.Request) {
+	resp := &csvResponse{w: w, filename: "users.csv", started: false}
+	resp.finish(r, h.exporter.ExportUsers(r.Context(), resp))
+}
+
+// ExportPullRequests - GET /export/pullRequests - streams all pull requests as CSV.
+func (h *TransferHandler) ExportPullRequests(w http.ResponseWriter, r *http.Request) {
+	resp := &csvResponse{w: w, filename: "pull_requests.csv", started: false}
+	resp.finish(r, h.exporter.ExportPullRequests(r.Context(), resp))
+}
+
+// csvResponse sends status and headers of CSV attachment on first write,
+// so export that fails before it gets regular error response.
+type csvResponse struct {
+	w        http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (c *csvResponse) Write(p []byte) (int, error) {
+	if !c.started {
+		c.started = true
+		c.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		c.w.Header().Set("Content-Disposition", `attachment; filename="`+c.filename+`"`)
+		c.w.WriteHeader(http.StatusOK)
+	}
+	return c.w.Write(p)
+}
+
+// finish reports error of export. Once response is started, it is aborted,
+// so client sees broken download instead of file cut in the middle.
+func (c *csvResponse) finish(r *http.Request, err error) {
+	if err == nil {
+		return
+	}
+	if !c.started {
+		handleError(c.w, err)
+		return
+	}
+	slog.ErrorContext(r.Context(), "Export failed after response was started", "filename", c.filename, "err", err)
+	panic(http.ErrAbortHandler)
+}
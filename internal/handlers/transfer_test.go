@@ -0,0 +1,48 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/transfer"
+)
+
+// failingUsers yields count users and then fails.
+type failingUsers struct {
+	count int
+}
+
+func (f failingUsers) ForEach(_ context.Context, fn func(model.User) error) error {
+	for i := range f.count {
+		err := fn(model.User{UserID: "u" + strconv.Itoa(i), Username: "User", TeamName: "backend", IsActive: true})
+		if err != nil {
+			return err
+		}
+	}
+	return assert.AnError
+}
+
+func exportUsers(count int) *httptest.ResponseRecorder {
+	h := handlers.NewTransferHandler(nil, &transfer.Exporter{User: failingUsers{count: count}, PR: nil})
+	rec := httptest.NewRecorder()
+	h.ExportUsers(rec, httptest.NewRequest(http.MethodGet, "/export/users", nil))
+	return rec
+}
+
+func TestExportUsers_Error(t *testing.T) {
+	// Nothing is sent yet, so error gets regular response.
+	rec := exportUsers(0)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Empty(t, rec.Header().Get("Content-Disposition"))
+
+	// Response is aborted once part of CSV is sent.
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() { exportUsers(1000) })
+}
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/LeonovDS/review-manager/api"
 	"github.com/LeonovDS/review-manager/internal/model"
 	"github.com/LeonovDS/review-manager/internal/usecase/user"
 )
@@ -13,62 +14,94 @@ import (
 type UserHandler struct {
 	reviews  *user.ReviewGetter
 	isActive *user.StatusUpdater
+	move     *user.TeamMover
 }
 
 // NewUserHandler creates new UserHandler.
 func NewUserHandler(
 	reviews *user.ReviewGetter,
 	isActive *user.StatusUpdater,
+	move *user.TeamMover,
 ) UserHandler {
 	return UserHandler{
 		reviews:  reviews,
 		isActive: isActive,
+		move:     move,
 	}
 }
 
-// GetReview - GET /users/getReview - gets list of pull requests reviewed by one user.
-func (u *UserHandler) GetReview(w http.ResponseWriter, r *http.Request) {
+// GetUserReviews - GET /users/getReview - gets list of pull requests reviewed by one user.
+func (u *UserHandler) GetUserReviews(w http.ResponseWriter, r *http.Request, params api.GetUserReviewsParams) {
 	ctx := r.Context()
-	uID := r.URL.Query().Get("user_id")
+	query, err := reviewQuery(params)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
 
-	report, err := u.reviews.Get(ctx, uID)
+	report, err := u.reviews.Get(ctx, params.UserID, query)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
-	err = json.NewEncoder(w).Encode(report)
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(report)
 	if err != nil {
-		slog.Error("Failed to write response", "err", err)
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
 		return
 	}
 }
 
-type setIsActiveRequest struct {
-	UID      string `json:"user_id"`
-	IsActive bool   `json:"is_active"`
+type userResponse struct {
+	User model.User `json:"user"`
 }
 
-// SetIsActive - Post /users/setIsActive - sets user's isActive status.
-func (u *UserHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
+// SetUserIsActive - Post /users/setIsActive - sets user's isActive status.
+func (u *UserHandler) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	var req setIsActiveRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
+	req, err := decodeJSON(w, r, validateSetUserIsActive)
 	if err != nil {
-		handleError(w, model.ErrBadRequest)
+		handleError(w, err)
 		return
 	}
 
-	user, err := u.isActive.SetIsActive(ctx, req.UID, req.IsActive)
+	user, err := u.isActive.SetIsActive(ctx, req.UserID, req.IsActive)
 	if err != nil {
 		handleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(userResponse{User: user})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
+		return
+	}
+}
+
+// MoveUserTeam - POST /users/moveTeam - moves user to another team.
+func (u *UserHandler) MoveUserTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req, err := decodeJSON(w, r, validateMoveUserTeam)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	change, err := u.move.MoveTeam(ctx, req.UserID, req.TeamName, value(req.ReassignReviews))
+	if err != nil {
+		handleError(w, err)
+		return
 	}
 
-	err = json.NewEncoder(w).Encode(user)
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(change)
 	if err != nil {
-		slog.Error("Failed to write response", "err", err)
+		slog.ErrorContext(r.Context(), "Failed to write response", "err", err)
 		return
 	}
 }
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"github.com/LeonovDS/review-manager/api"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// Rules are shared with gRPC API in model, functions below only pick fields of request bodies.
+
+func validateTeam(v *model.ValidationError, req api.Team) {
+	v.CheckTeam(teamFromAPI(req))
+}
+
+func validateRenameTeam(v *model.ValidationError, req api.RenameTeamJSONRequestBody) {
+	v.CheckName("team_name", req.TeamName, model.MaxNameLength)
+	v.CheckName("new_team_name", req.NewTeamName, model.MaxNameLength)
+}
+
+func validateArchiveTeam(v *model.ValidationError, req api.ArchiveTeamJSONRequestBody) {
+	v.CheckName("team_name", req.TeamName, model.MaxNameLength)
+}
+
+func validateDeleteTeam(v *model.ValidationError, req api.DeleteTeamJSONRequestBody) {
+	v.CheckName("team_name", req.TeamName, model.MaxNameLength)
+	if req.TargetTeamName != nil {
+		v.CheckName("target_team_name", *req.TargetTeamName, model.MaxNameLength)
+	}
+}
+
+func validateRemoveTeamMembers(v *model.ValidationError, req api.RemoveTeamMembersJSONRequestBody) {
+	v.CheckName("team_name", req.TeamName, model.MaxNameLength)
+	v.CheckIDs("user_ids", req.UserIds)
+}
+
+func validateSetUserIsActive(v *model.ValidationError, req api.SetUserIsActiveJSONRequestBody) {
+	v.CheckID("user_id", req.UserID)
+}
+
+func validateMoveUserTeam(v *model.ValidationError, req api.MoveUserTeamJSONRequestBody) {
+	v.CheckMove(req.UserID, req.TeamName)
+}
+
+func validateCreatePullRequest(v *model.ValidationError, req api.CreatePullRequestJSONRequestBody) {
+	v.CheckPullRequest(req.PullRequestID, req.PullRequestName, req.AuthorID)
+}
+
+func validateMergePullRequest(v *model.ValidationError, req api.MergePullRequestJSONRequestBody) {
+	v.CheckID("pull_request_id", req.PullRequestID)
+}
+
+func validateReassignPullRequest(v *model.ValidationError, req api.ReassignPullRequestJSONRequestBody) {
+	v.CheckReviewer(model.Reviewer{PRID: req.PullRequestID, UID: req.OldUserID})
+}
+
+func validateCreateAPIKey(v *model.ValidationError, req api.CreateAPIKeyJSONRequestBody) {
+	v.CheckName("name", req.Name, model.MaxNameLength)
+	if len(req.Scopes) == 0 {
+		v.Add("scopes", "must not be empty")
+	}
+	for i, scope := range req.Scopes {
+		if !scope.Valid() {
+			v.Add(model.FieldPath("scopes", i, ""), "must be one of read, write:pr, admin:team")
+		}
+	}
+}
+
+func validateRevokeAPIKey(v *model.ValidationError, req api.RevokeAPIKeyJSONRequestBody) {
+	v.CheckID("key_id", req.KeyID)
+}
@@ -0,0 +1,107 @@
+// Package health reports readiness of the service and its dependencies.
+package health
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Status is result of a single check or of the whole report.
+type Status string
+
+// Check statuses.
+const (
+	StatusOK   Status = "ok"
+	StatusFail Status = "fail"
+)
+
+// CheckFunc checks single dependency and returns error if it is not usable.
+type CheckFunc func(ctx context.Context) error
+
+// Check is result of CheckFunc.
+type Check struct {
+	Name   string `json:"name"`
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is result of all checks. Status is ok only if every check passed.
+type Report struct {
+	Status Status  `json:"status"`
+	Checks []Check `json:"checks"`
+}
+
+// Checker runs registered checks in order.
+type Checker struct {
+	names  []string
+	checks []CheckFunc
+}
+
+// Add registers check under given name.
+func (c *Checker) Add(name string, check CheckFunc) {
+	c.names = append(c.names, name)
+	c.checks = append(c.checks, check)
+}
+
+// Check runs all checks and collects report.
+func (c *Checker) Check(ctx context.Context) Report {
+	report := Report{Status: StatusOK, Checks: make([]Check, 0, len(c.checks))}
+	for i, check := range c.checks {
+		result := Check{Name: c.names[i], Status: StatusOK, Error: ""}
+		err := check(ctx)
+		if err != nil {
+			result.Status = StatusFail
+			result.Error = err.Error()
+			report.Status = StatusFail
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report
+}
+
+// Workers tracks state of background workers. Zero value is ready to use.
+type Workers struct {
+	mu      sync.Mutex
+	stopped map[string]error
+}
+
+// Running marks worker as started.
+func (w *Workers) Running(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.stopped, name)
+}
+
+// Stopped marks worker as finished with err, which is nil on normal stop.
+func (w *Workers) Stopped(name string, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stopped == nil {
+		w.stopped = make(map[string]error)
+	}
+	w.stopped[name] = err
+}
+
+// Check fails if any worker has stopped.
+func (w *Workers) Check(_ context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	problems := make([]string, 0, len(w.stopped))
+	for _, name := range slices.Sorted(maps.Keys(w.stopped)) {
+		err := w.stopped[name]
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s failed: %v", name, err))
+		} else {
+			problems = append(problems, name+" stopped")
+		}
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("workers are not running: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
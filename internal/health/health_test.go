@@ -0,0 +1,44 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckerReport(t *testing.T) {
+	var checker health.Checker
+	checker.Add("database", func(_ context.Context) error { return nil })
+	checker.Add("migrations", func(_ context.Context) error { return errors.New("schema version 5, expected 6") })
+
+	report := checker.Check(t.Context())
+
+	assert.Equal(t, health.Report{
+		Status: health.StatusFail,
+		Checks: []health.Check{
+			{Name: "database", Status: health.StatusOK, Error: ""},
+			{Name: "migrations", Status: health.StatusFail, Error: "schema version 5, expected 6"},
+		},
+	}, report)
+}
+
+func TestWorkersCheck(t *testing.T) {
+	var workers health.Workers
+	assert.NoError(t, workers.Check(t.Context()))
+
+	workers.Running("events")
+	workers.Running("cleanup")
+	assert.NoError(t, workers.Check(t.Context()))
+
+	workers.Stopped("events", errors.New("connection lost"))
+	workers.Stopped("cleanup", nil)
+	assert.EqualError(t, workers.Check(t.Context()),
+		"workers are not running: cleanup stopped; events failed: connection lost")
+
+	workers.Running("events")
+	workers.Running("cleanup")
+	assert.NoError(t, workers.Check(t.Context()))
+}
@@ -0,0 +1,160 @@
+// Package limit keeps one client or burst of requests from exhausting database pool.
+// Limiter and Slots are shared by HTTP and gRPC APIs, so both count against the same limits.
+//
+// Methods of nil *Limiter and nil *Slots never reject anything, so limits can be disabled by passing nil.
+package limit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Rate is token bucket: Burst requests at once, refilled by Rate requests per second.
+type Rate struct {
+	Rate  float64
+	Burst int
+}
+
+// Rates configures limiter of requests per client.
+type Rates struct {
+	// Default limits every route of client together, zero rate disables it.
+	Default Rate
+	// Routes gives operations in form "METHOD /path" their own bucket with other limit.
+	Routes map[string]Rate
+}
+
+// enabled reports whether any request is limited.
+func (r Rates) enabled() bool {
+	return r.Default.Rate > 0 || len(r.Routes) != 0
+}
+
+// idleBucketsSweep is how often buckets that are full again are forgotten.
+const idleBucketsSweep = time.Minute
+
+type bucketKey struct {
+	client string
+	// route is empty for default bucket.
+	route string
+}
+
+// Limiter keeps token bucket of every client and limited route.
+type Limiter struct {
+	rates Rates
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*rate.Limiter
+	swept   time.Time
+}
+
+// NewLimiter creates limiter with given rates, it returns nil if rates don't limit anything.
+func NewLimiter(rates Rates, now func() time.Time) *Limiter {
+	if !rates.enabled() {
+		return nil
+	}
+	return &Limiter{
+		rates:   rates,
+		now:     now,
+		mu:      sync.Mutex{},
+		buckets: map[bucketKey]*rate.Limiter{},
+		swept:   now(),
+	}
+}
+
+// FailedAuthClient returns client charged for requests from address host that failed authentication.
+// Its bucket is checked before token is looked up, so guessing tokens can't exhaust database pool.
+func FailedAuthClient(host string) string {
+	return "auth-failed:" + host
+}
+
+// Reserve takes token from bucket of client for route.
+// It returns zero if request is allowed, otherwise how long client should wait.
+func (l *Limiter) Reserve(client, route string) time.Duration {
+	return l.reserve(client, route, true)
+}
+
+// Check returns how long client should wait like Reserve does, but takes no token.
+func (l *Limiter) Check(client, route string) time.Duration {
+	return l.reserve(client, route, false)
+}
+
+func (l *Limiter) reserve(client, route string, take bool) time.Duration {
+	if l == nil {
+		return 0
+	}
+	limit, ok := l.rates.Routes[route]
+	if !ok {
+		if l.rates.Default.Rate <= 0 {
+			return 0
+		}
+		limit, route = l.rates.Default, ""
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	key := bucketKey{client: client, route: route}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+		l.buckets[key] = bucket
+	}
+	reservation := bucket.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay > 0 || !take {
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// sweep forgets buckets that are full again, they are recreated full on next request.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < idleBucketsSweep {
+		return
+	}
+	l.swept = now
+	for key, bucket := range l.buckets {
+		if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Slots limits number of requests served at once.
+type Slots struct {
+	ch chan struct{}
+}
+
+// NewSlots creates max slots, it returns nil if max is not positive.
+func NewSlots(maxInFlight int) *Slots {
+	if maxInFlight <= 0 {
+		return nil
+	}
+	return &Slots{ch: make(chan struct{}, maxInFlight)}
+}
+
+// Acquire takes free slot without waiting. It reports false if every slot is taken.
+// Taken slot must be returned with Release.
+func (s *Slots) Acquire() bool {
+	if s == nil {
+		return true
+	}
+	select {
+	case s.ch <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release returns slot taken by Acquire.
+func (s *Slots) Release() {
+	if s == nil {
+		return
+	}
+	<-s.ch
+}
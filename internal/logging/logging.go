@@ -0,0 +1,62 @@
+// Package logging builds slog handlers and carries request scope in context.
+//
+// Records written with slog.*Context functions get request_id of the request being served
+// and actor that sent it, so use cases only need to pass ctx through.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID stores request id in context.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns request id stored in context or empty string.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewHandler creates text or JSON handler writing to w, which adds request scope to records.
+func NewHandler(w io.Writer, level slog.Level, json bool) *ContextHandler {
+	opts := &slog.HandlerOptions{Level: level} //nolint:exhaustruct
+	if json {
+		return &ContextHandler{Handler: slog.NewJSONHandler(w, opts)}
+	}
+	return &ContextHandler{Handler: slog.NewTextHandler(w, opts)}
+}
+
+// ContextHandler adds request_id, actor and its organization from context to log records.
+type ContextHandler struct {
+	slog.Handler
+}
+
+// Handle implements slog.Handler.
+func (h *ContextHandler) Handle(ctx context.Context, r slog.Record) error { //nolint:gocritic // slog.Handler signature
+	id := RequestID(ctx)
+	if len(id) != 0 {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if actor, ok := model.ActorFrom(ctx); ok {
+		r.AddAttrs(slog.String("actor", actor.ID), slog.String("org", actor.Org))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// scrapeTimeout limits database query made during scrape.
+const scrapeTimeout = 2 * time.Second
+
+// poolCollector reads pgxpool statistics on scrape.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquired      *prometheus.Desc
+	idle          *prometheus.Desc
+	total         *prometheus.Desc
+	maxConns      *prometheus.Desc
+	acquires      *prometheus.Desc
+	emptyAcquires *prometheus.Desc
+	acquireTime   *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		pool:          pool,
+		acquired:      desc("acquired_connections", "Number of connections currently in use."),
+		idle:          desc("idle_connections", "Number of idle connections."),
+		total:         desc("total_connections", "Number of open connections."),
+		maxConns:      desc("max_connections", "Maximum size of pool."),
+		acquires:      desc("acquires_total", "Number of successful acquires."),
+		emptyAcquires: desc("empty_acquires_total", "Number of acquires that waited for free connection."),
+		acquireTime:   desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquired
+	ch <- c.idle
+	ch <- c.total
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.emptyAcquires
+	ch <- c.acquireTime
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquired, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireTime, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
+
+// openReviewsCollector queries open reviews per user on scrape, so gauge is always consistent with database.
+type openReviewsCollector struct {
+	count func(ctx context.Context) (map[model.OrgUser]int, error)
+	desc  *prometheus.Desc
+}
+
+func newOpenReviewsCollector(count func(ctx context.Context) (map[model.OrgUser]int, error)) *openReviewsCollector {
+	return &openReviewsCollector{
+		count: count,
+		desc: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "open_reviews"),
+			"Number of open pull requests assigned to user.", []string{"org_id", "user_id"}, nil),
+	}
+}
+
+func (c *openReviewsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *openReviewsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	counts, err := c.count(ctx)
+	if err != nil {
+		slog.Error("Failed to count open reviews", slog.Any("err", err))
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	for user, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), user.Org, user.UserID)
+	}
+}
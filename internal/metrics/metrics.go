@@ -0,0 +1,213 @@
+// Package metrics exposes Prometheus metrics of HTTP and gRPC servers, database pool and reviewer assignment.
+//
+// Methods of nil *Metrics do nothing, so metrics can be disabled by passing nil.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "review_manager"
+
+// Metrics holds own registry and collectors of the service.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	calls           *prometheus.CounterVec
+	callDuration    *prometheus.HistogramVec
+
+	assignments      prometheus.Counter
+	reviewersPerPR   prometheus.Histogram
+	noCandidate      prometheus.Counter
+	reassignmentsPer *prometheus.CounterVec
+}
+
+// New creates Metrics with Go runtime and process collectors registered.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests by route and status code.",
+		}, []string{"method", "route", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of HTTP requests by route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "grpc_calls_total",
+			Help:      "Number of gRPC calls by method and status code.",
+		}, []string{"method", "code"}),
+		callDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "grpc_call_duration_seconds",
+			Help:      "Latency of gRPC calls by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		assignments: prometheus.NewCounter(prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "reviewer_assignments_total",
+			Help:      "Number of reviewers assigned on pull request creation and reassignment.",
+		}),
+		reviewersPerPR: prometheus.NewHistogram(prometheus.HistogramOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "pull_request_reviewers",
+			Help:      "Number of reviewers assigned to created pull request.",
+			Buckets:   []float64{0, 1, 2, 3, 5},
+		}),
+		noCandidate: prometheus.NewCounter(prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "no_candidate_total",
+			Help:      "Number of reassignments failed with NO_CANDIDATE.",
+		}),
+		reassignmentsPer: prometheus.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "reassignments_total",
+			Help:      "Number of successful reassignments by organization and team of replaced reviewer.",
+		}, []string{"org_id", "team"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}), //nolint:exhaustruct
+		m.requests, m.requestDuration, m.calls, m.callDuration,
+		m.assignments, m.reviewersPerPR, m.noCandidate, m.reassignmentsPer,
+	)
+	return m
+}
+
+// Handler serves metrics in Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}) //nolint:exhaustruct
+}
+
+// Middleware counts requests and measures latency. It must wrap http.ServeMux,
+// because route is taken from pattern matched by mux.
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	if m == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		route := "unmatched"
+		if len(r.Pattern) != 0 {
+			_, path, found := strings.Cut(r.Pattern, " ")
+			if !found {
+				path = r.Pattern
+			}
+			route = path
+		}
+		m.requests.WithLabelValues(r.Method, route, strconv.Itoa(sw.status)).Inc()
+		m.requestDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// UnaryInterceptor counts unary gRPC calls and measures their latency.
+func (m *Metrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observeCall(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamInterceptor counts streaming gRPC calls and measures their duration.
+func (m *Metrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observeCall(info.FullMethod, start, err)
+		return err
+	}
+}
+
+func (m *Metrics) observeCall(method string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	m.calls.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.callDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// Assigned records reviewers assigned to created pull request.
+func (m *Metrics) Assigned(count int) {
+	if m == nil {
+		return
+	}
+	m.assignments.Add(float64(count))
+	m.reviewersPerPR.Observe(float64(count))
+}
+
+// Reassigned records successful reassignment within team of organization, team names are unique only within it.
+func (m *Metrics) Reassigned(org, team string) {
+	if m == nil {
+		return
+	}
+	m.assignments.Inc()
+	m.reassignmentsPer.WithLabelValues(org, team).Inc()
+}
+
+// NoCandidate records reassignment failed because nobody can review pull request.
+func (m *Metrics) NoCandidate() {
+	if m == nil {
+		return
+	}
+	m.noCandidate.Inc()
+}
+
+// RegisterPool exports statistics of database connection pool.
+func (m *Metrics) RegisterPool(pool *pgxpool.Pool) {
+	if m == nil {
+		return
+	}
+	m.registry.MustRegister(newPoolCollector(pool))
+}
+
+// RegisterOpenReviews exports number of open reviews per user of each organization, counted on each scrape.
+func (m *Metrics) RegisterOpenReviews(count func(ctx context.Context) (map[model.OrgUser]int, error)) {
+	if m == nil {
+		return
+	}
+	m.registry.MustRegister(newOpenReviewsCollector(count))
+}
+
+// statusWriter remembers response status code.
+type statusWriter struct {
+	http.ResponseWriter
+
+	status int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Unwrap lets http.ResponseController reach underlying writer, e.g. for flushing.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,85 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/metrics"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	rr := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	return rr.Body.String()
+}
+
+func TestMiddlewareUsesRoutePattern(t *testing.T) {
+	m := metrics.New()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /team/get", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	handler := m.Middleware(mux)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/team/get?team_name=a", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/unknown/x", nil))
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `review_manager_http_requests_total{code="404",method="GET",route="/team/get"} 1`)
+	assert.Contains(t, body, `review_manager_http_requests_total{code="404",method="GET",route="unmatched"} 1`)
+	assert.Contains(t, body, `review_manager_http_request_duration_seconds_count{method="GET",route="/team/get"} 1`)
+}
+
+func TestInterceptorCountsCalls(t *testing.T) {
+	m := metrics.New()
+	unary := m.UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{Server: nil, FullMethod: "/reviewmanager.v1.ReviewManager/GetTeam"}
+
+	_, _ = unary(t.Context(), nil, info, func(context.Context, any) (any, error) { return nil, nil })
+	_, _ = unary(t.Context(), nil, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+
+	body := scrape(t, m)
+	method := `method="/reviewmanager.v1.ReviewManager/GetTeam"`
+	assert.Contains(t, body, `review_manager_grpc_calls_total{code="OK",`+method+`} 1`)
+	assert.Contains(t, body, `review_manager_grpc_calls_total{code="NotFound",`+method+`} 1`)
+	assert.Contains(t, body, `review_manager_grpc_call_duration_seconds_count{`+method+`} 2`)
+}
+
+func TestAssignmentMetrics(t *testing.T) {
+	m := metrics.New()
+	m.Assigned(2)
+	m.Assigned(1)
+	m.Reassigned("acme", "backend")
+	m.Reassigned("other", "backend")
+	m.NoCandidate()
+
+	body := scrape(t, m)
+	assert.Contains(t, body, "review_manager_reviewer_assignments_total 5")
+	assert.Contains(t, body, "review_manager_pull_request_reviewers_count 2")
+	assert.Contains(t, body, `review_manager_reassignments_total{org_id="acme",team="backend"} 1`)
+	assert.Contains(t, body, `review_manager_reassignments_total{org_id="other",team="backend"} 1`)
+	assert.Contains(t, body, "review_manager_no_candidate_total 1")
+}
+
+func TestNilMetricsIsNoop(t *testing.T) {
+	var m *metrics.Metrics
+	m.Assigned(2)
+	m.Reassigned("acme", "backend")
+	m.NoCandidate()
+
+	next := http.NotFoundHandler()
+	assert.NotNil(t, m.Middleware(next))
+	_, err := m.UnaryInterceptor()(t.Context(), nil, &grpc.UnaryServerInfo{Server: nil, FullMethod: "/x/Y"},
+		func(context.Context, any) (any, error) { return nil, nil })
+	assert.NoError(t, err)
+}
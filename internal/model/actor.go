@@ -0,0 +1,98 @@
+package model
+
+import (
+	"context"
+	"slices"
+)
+
+// Role is role of engineer authenticated with JWT.
+type Role string
+
+// Roles of engineers.
+const (
+	// RoleMember reads everything and works with own pull requests.
+	RoleMember Role = "member"
+	// RoleTeamLead also manages own team.
+	RoleTeamLead Role = "team_lead"
+	// RoleAdmin can do everything.
+	RoleAdmin Role = "admin"
+)
+
+// Scopes returns scopes granted to role. Actor rules narrow them to own team and reviews.
+func (r Role) Scopes() []Scope {
+	switch r {
+	case RoleAdmin, RoleTeamLead:
+		return Scopes()
+	default:
+		return []Scope{ScopeRead, ScopeWritePR}
+	}
+}
+
+// Identity is engineer that token was issued for.
+type Identity struct {
+	// Org is organization of engineer, empty if token does not name it.
+	Org    string
+	UserID string
+	Roles  []Role
+}
+
+// Role returns the most powerful role of identity.
+func (i Identity) Role() Role {
+	for _, r := range []Role{RoleAdmin, RoleTeamLead} {
+		if slices.Contains(i.Roles, r) {
+			return r
+		}
+	}
+	return RoleMember
+}
+
+// Actor is identity on whose behalf request is served: API key or engineer.
+type Actor struct {
+	// ID identifies actor in logs and spans, it is key id or user id.
+	ID   string
+	Name string
+	// Org is organization that actor works in, it scopes all data available to actor.
+	Org string
+	// UserID is set for engineers. API keys act as services and are limited only by scopes.
+	UserID string
+	Role   Role
+	// Team is team of engineer.
+	Team   string
+	Scopes []Scope
+}
+
+// limited reports whether actor is engineer allowed to change only own team and reviews.
+func (a Actor) limited() bool {
+	return len(a.UserID) != 0 && a.Role != RoleAdmin
+}
+
+// CanManageAll reports whether actor may change several teams at once, e.g. sync roster or manage API keys.
+func (a Actor) CanManageAll() bool {
+	return !a.limited()
+}
+
+// CanManageTeam reports whether actor may change team and its members. Team leads manage only own team.
+func (a Actor) CanManageTeam(team string) bool {
+	return !a.limited() || (a.Role == RoleTeamLead && len(a.Team) != 0 && a.Team == team)
+}
+
+// CanReassign reports whether actor may reassign reviews of pull request: engineers only reassign
+// pull requests they author or review, team leads also reviews of their team members.
+func (a Actor) CanReassign(pr PullRequest, reviewerTeam string) bool {
+	return !a.limited() || a.UserID == pr.AuthorID || slices.Contains(pr.Reviewers, a.UserID) ||
+		a.CanManageTeam(reviewerTeam)
+}
+
+type actorKey struct{}
+
+// WithActor stores actor in context, so use cases can record who performed action.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns actor stored in context. Requests without actor, e.g. from command line,
+// get zero actor that is limited by nothing.
+func ActorFrom(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// Scope is permission granted to API key.
+type Scope string
+
+// Scopes of API keys.
+const (
+	// ScopeRead allows to read teams, users and pull requests.
+	ScopeRead Scope = "read"
+	// ScopeWritePR allows to create, merge and reassign pull requests.
+	ScopeWritePR Scope = "write:pr"
+	// ScopeAdminTeam allows to manage teams, users and API keys.
+	ScopeAdminTeam Scope = "admin:team"
+)
+
+// Scopes lists every known scope.
+func Scopes() []Scope {
+	return []Scope{ScopeRead, ScopeWritePR, ScopeAdminTeam}
+}
+
+// APIKey is credential of client, only hash of its token is stored.
+type APIKey struct {
+	ID        string     `json:"key_id"`
+	Name      string     `json:"name"`
+	Org       string     `json:"org_id"`
+	Scopes    []Scope    `json:"scopes"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// IssuedAPIKey is newly created key with its token, token is shown only once.
+type IssuedAPIKey struct {
+	Key   APIKey `json:"key"`
+	Token string `json:"token"`
+}
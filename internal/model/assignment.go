@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Kinds of assignment events.
+const (
+	// AssignmentAssigned is reviewer assigned to new pull request.
+	AssignmentAssigned = "ASSIGNED"
+	// AssignmentReassigned is reviewer that replaced previous one.
+	AssignmentReassigned = "REASSIGNED"
+	// AssignmentMerged is pull request that was merged, event is published for each of its reviewers.
+	AssignmentMerged = "MERGED"
+)
+
+// AssignmentEvent describes change of pull request reviewers or its merge.
+// Events are published when change is committed.
+type AssignmentEvent struct {
+	Type     string `json:"type"`
+	Org      string `json:"org_id"`
+	PRID     string `json:"pull_request_id"`
+	AuthorID string `json:"author_id"`
+	// TeamName is team of reviewers, the same as team of author when pull request was created.
+	TeamName   string    `json:"team_name"`
+	ReviewerID string    `json:"reviewer_id,omitempty"`
+	PreviousID string    `json:"previous_reviewer_id,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+// Involves reports whether user is author, reviewer or replaced reviewer of event.
+func (e AssignmentEvent) Involves(userID string) bool {
+	return e.AuthorID == userID || e.ReviewerID == userID || e.PreviousID == userID
+}
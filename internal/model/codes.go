@@ -0,0 +1,89 @@
+package model
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrorKind tells how error is reported to clients. HTTP API, gRPC API and reviewctl
+// derive their responses from the same table, so every client sees the same code.
+type ErrorKind struct {
+	// Code is code of error in responses of both APIs, e.g. PR_MERGED.
+	Code string
+	// HTTPStatus is status of HTTP response.
+	HTTPStatus int
+	// GRPCCode is status code of gRPC call.
+	GRPCCode codes.Code
+	// Exit is exit code of reviewctl.
+	Exit int
+}
+
+// internalError is kind of every error not listed in errorKinds.
+//
+//nolint:gochecknoglobals,mnd
+var internalError = ErrorKind{
+	Code: "INTERNAL_ERROR", HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal, Exit: 1,
+}
+
+// errorKinds maps errors of use cases to their kinds, the first matching error wins.
+// Exit codes are part of reviewctl interface, so they must never change.
+//
+//nolint:gochecknoglobals,mnd
+var errorKinds = []struct {
+	err  error
+	kind ErrorKind
+}{
+	{ErrBadRequest, ErrorKind{"BAD_REQUEST", http.StatusBadRequest, codes.InvalidArgument, 2}},
+	{ErrUnsupportedMediaType, ErrorKind{
+		"UNSUPPORTED_MEDIA_TYPE", http.StatusUnsupportedMediaType, codes.InvalidArgument, 16,
+	}},
+	{ErrTooLarge, ErrorKind{"PAYLOAD_TOO_LARGE", http.StatusRequestEntityTooLarge, codes.ResourceExhausted, 17}},
+	{ErrTeamExists, ErrorKind{"TEAM_EXISTS", http.StatusBadRequest, codes.AlreadyExists, 4}},
+	{ErrPRExists, ErrorKind{"PR_EXISTS", http.StatusConflict, codes.AlreadyExists, 5}},
+	{ErrNotFound, ErrorKind{"NOT_FOUND", http.StatusNotFound, codes.NotFound, 3}},
+	{ErrPRMerged, ErrorKind{"PR_MERGED", http.StatusConflict, codes.FailedPrecondition, 6}},
+	{ErrNoCandidate, ErrorKind{"NO_CANDIDATE", http.StatusConflict, codes.FailedPrecondition, 8}},
+	{ErrTeamHasOpenPRs, ErrorKind{"TEAM_HAS_OPEN_PRS", http.StatusConflict, codes.FailedPrecondition, 9}},
+	{ErrTeamArchived, ErrorKind{"TEAM_ARCHIVED", http.StatusConflict, codes.FailedPrecondition, 10}},
+	{ErrUserInOtherTeam, ErrorKind{"USER_IN_OTHER_TEAM", http.StatusConflict, codes.FailedPrecondition, 11}},
+	{ErrNotAssigned, ErrorKind{"NOT_ASSIGNED", http.StatusConflict, codes.FailedPrecondition, 7}},
+	{ErrUnauthorized, ErrorKind{"UNAUTHORIZED", http.StatusUnauthorized, codes.Unauthenticated, 12}},
+	{ErrForbidden, ErrorKind{"FORBIDDEN", http.StatusForbidden, codes.PermissionDenied, 13}},
+	{ErrIdempotencyKeyReused, ErrorKind{
+		"IDEMPOTENCY_KEY_REUSED", http.StatusUnprocessableEntity, codes.FailedPrecondition, 18,
+	}},
+	{ErrRequestInProgress, ErrorKind{"REQUEST_IN_PROGRESS", http.StatusConflict, codes.Aborted, 19}},
+	{ErrRateLimited, ErrorKind{"TOO_MANY_REQUESTS", http.StatusTooManyRequests, codes.ResourceExhausted, 14}},
+	{ErrOverloaded, ErrorKind{"OVERLOADED", http.StatusServiceUnavailable, codes.Unavailable, 15}},
+}
+
+// KindOf returns kind of err, errors of unknown kind are INTERNAL_ERROR.
+func KindOf(err error) ErrorKind {
+	for _, k := range errorKinds {
+		if errors.Is(err, k.err) {
+			return k.kind
+		}
+	}
+	return internalError
+}
+
+// KindByCode returns kind of error with code received from API. It reports false for unknown codes.
+func KindByCode(code string) (ErrorKind, bool) {
+	for _, k := range ErrorKinds() {
+		if k.Code == code {
+			return k, true
+		}
+	}
+	return ErrorKind{}, false
+}
+
+// ErrorKinds lists kind of every error reported by APIs, including INTERNAL_ERROR.
+func ErrorKinds() []ErrorKind {
+	kinds := make([]ErrorKind, 0, len(errorKinds)+1)
+	for _, k := range errorKinds {
+		kinds = append(kinds, k.kind)
+	}
+	return append(kinds, internalError)
+}
@@ -24,3 +24,36 @@ var ErrNotAssigned = errors.New("not assigned")
 
 // ErrPRMerged is used when attempted to reassign review of already merged pull request.
 var ErrPRMerged = errors.New("pr merged")
+
+// ErrTeamHasOpenPRs is used when attempted to delete team with open pull requests.
+var ErrTeamHasOpenPRs = errors.New("team has open pull requests")
+
+// ErrTeamArchived is used when attempted to add members to archived team.
+var ErrTeamArchived = errors.New("team archived")
+
+// ErrUserInOtherTeam is used when attempted to add member of another team without moving it.
+var ErrUserInOtherTeam = errors.New("user belongs to other team")
+
+// ErrUnsupportedMediaType is used when request body has content type that operation does not accept.
+var ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+// ErrTooLarge is used when request body exceeds size limit.
+var ErrTooLarge = errors.New("request body too large")
+
+// ErrIdempotencyKeyReused is used when idempotency key is sent again with different request body.
+var ErrIdempotencyKeyReused = errors.New("idempotency key is already used for other request")
+
+// ErrRequestInProgress is used when request with the same idempotency key is not finished yet.
+var ErrRequestInProgress = errors.New("request with the same idempotency key is in progress")
+
+// ErrUnauthorized is used when request has no valid API key.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden is used when API key lacks scope required by operation.
+var ErrForbidden = errors.New("forbidden")
+
+// ErrRateLimited is used when client sends requests faster than its rate limit.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// ErrOverloaded is used when server already serves as many requests as it can.
+var ErrOverloaded = errors.New("too many requests in flight")
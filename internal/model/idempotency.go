@@ -0,0 +1,21 @@
+package model
+
+// IdempotencyKey identifies request by key chosen by client and operation it was sent to.
+type IdempotencyKey struct {
+	Key   string
+	Route string
+}
+
+// StoredResponse is response saved to be replayed for retries of the same request.
+type StoredResponse struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotentRequest is request saved under idempotency key.
+// Response is nil while the original request is in progress.
+type IdempotentRequest struct {
+	Hash     []byte
+	Response *StoredResponse
+}
@@ -0,0 +1,24 @@
+package model
+
+// RowError describes invalid row of imported file.
+type RowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+	// Code is code of Err in terms of API, it is filled by transport.
+	Code string `json:"code,omitempty"`
+	// Err is why row is invalid, it matches ErrBadRequest, ErrUserInOtherTeam or ErrTeamArchived.
+	Err error `json:"-"`
+}
+
+// NewRowError describes row rejected with err.
+func NewRowError(row int, err error) RowError {
+	return RowError{Row: row, Message: err.Error(), Code: "", Err: err}
+}
+
+// ImportResult summarizes import of users.
+// If any row is invalid, nothing is imported and Errors lists all problems.
+type ImportResult struct {
+	Imported     int        `json:"imported"`
+	CreatedTeams []string   `json:"created_teams"`
+	Errors       []RowError `json:"errors,omitempty"`
+}
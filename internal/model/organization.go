@@ -0,0 +1,29 @@
+package model
+
+import "context"
+
+// DefaultOrg is organization that owns data created before organizations were introduced
+// and requests that are not bound to any organization, e.g. from command line.
+const DefaultOrg = "default"
+
+// OrgUser identifies user across organizations, user ids are unique only within organization.
+type OrgUser struct {
+	Org    string
+	UserID string
+}
+
+type orgKey struct{}
+
+// WithOrg stores organization in context, every repository query is scoped by it.
+func WithOrg(ctx context.Context, org string) context.Context {
+	return context.WithValue(ctx, orgKey{}, org)
+}
+
+// OrgFrom returns organization stored in context or DefaultOrg.
+func OrgFrom(ctx context.Context) string {
+	org, ok := ctx.Value(orgKey{}).(string)
+	if !ok || len(org) == 0 {
+		return DefaultOrg
+	}
+	return org
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Cursor points to position in list of pull requests ordered by creation time.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Encode serializes cursor into opaque string for API clients.
+func (c Cursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses cursor produced by Cursor.Encode.
+func DecodeCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", ErrBadRequest)
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || len(id) == 0 {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", ErrBadRequest)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", ErrBadRequest)
+	}
+	return Cursor{CreatedAt: t, ID: id}, nil
+}
@@ -18,3 +18,33 @@ type Reviewer struct {
 	PRID string `json:"pull_request_id"`
 	UID  string `json:"old_user_id"`
 }
+
+// Reassignment is pull request after its reviewer was replaced.
+type Reassignment struct {
+	PR         PullRequest `json:"pr"`
+	ReplacedBy string      `json:"replaced_by"`
+}
+
+// PullRequestFilter describes criteria for listing pull requests.
+// Empty fields are not used for filtering.
+type PullRequestFilter struct {
+	Status      string
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	Name        string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	After       *Cursor
+	Descending  bool
+	Limit       int
+}
+
+// PullRequestPage is one page of pull requests ordered by creation time.
+type PullRequestPage struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+	Total        int           `json:"total"`
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxIDLength limits length of user and pull request identifiers.
+	MaxIDLength = 64
+	// MaxNameLength limits length of team names and usernames.
+	MaxNameLength = 100
+	// MaxTitleLength limits length of pull request names.
+	MaxTitleLength = 200
+)
+
+// idPattern allows identifiers that are safe to use in URLs and logs without escaping.
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`) //nolint:gochecknoglobals // compiled once
+
+// CheckID validates identifier of user, pull request or API key.
+func (e *ValidationError) CheckID(field, id string) {
+	switch {
+	case len(id) == 0:
+		e.Add(field, "is required")
+	case utf8.RuneCountInString(id) > MaxIDLength:
+		e.Add(field, "must be at most %d characters", MaxIDLength)
+	case !idPattern.MatchString(id):
+		e.Add(field, "must contain only latin letters, digits, '.', '_' and '-' and start with letter or digit")
+	}
+}
+
+// CheckName validates human-readable name, it must not be blank or padded with whitespace.
+func (e *ValidationError) CheckName(field, name string, maxLength int) {
+	switch {
+	case len(strings.TrimSpace(name)) == 0:
+		e.Add(field, "is required")
+	case utf8.RuneCountInString(name) > maxLength:
+		e.Add(field, "must be at most %d characters", maxLength)
+	case strings.TrimSpace(name) != name:
+		e.Add(field, "must not start or end with whitespace")
+	}
+}
+
+// CheckUnique reports every repeated value with index of its first occurrence.
+func (e *ValidationError) CheckUnique(path, field string, values []string) {
+	seen := make(map[string]int, len(values))
+	for i, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		first, ok := seen[value]
+		if !ok {
+			seen[value] = i
+			continue
+		}
+		e.Add(FieldPath(path, i, field), "duplicates %s", FieldPath(path, first, field))
+	}
+}
+
+// CheckIDs validates non-empty list of unique identifiers.
+func (e *ValidationError) CheckIDs(path string, ids []string) {
+	if len(ids) == 0 {
+		e.Add(path, "must not be empty")
+	}
+	for i, id := range ids {
+		e.CheckID(FieldPath(path, i, ""), id)
+	}
+	e.CheckUnique(path, "", ids)
+}
+
+// CheckMembers validates team members, user_id must be unique within team.
+func (e *ValidationError) CheckMembers(path string, members []User) {
+	if len(members) == 0 {
+		e.Add(path, "must not be empty")
+		return
+	}
+	ids := make([]string, 0, len(members))
+	for i, m := range members {
+		e.CheckID(FieldPath(path, i, "user_id"), m.UserID)
+		e.CheckName(FieldPath(path, i, "username"), m.Username, MaxNameLength)
+		ids = append(ids, m.UserID)
+	}
+	e.CheckUnique(path, "user_id", ids)
+}
+
+// CheckTeam validates team with its members.
+func (e *ValidationError) CheckTeam(team Team) {
+	e.CheckName("team_name", team.TeamName, MaxNameLength)
+	e.CheckMembers("members", team.Members)
+}
+
+// CheckRoster validates every team of roster.
+func (e *ValidationError) CheckRoster(roster Roster) {
+	if len(roster.Teams) == 0 {
+		e.Add("teams", "must not be empty")
+		return
+	}
+	for i, team := range roster.Teams {
+		e.CheckName(FieldPath("teams", i, "team_name"), team.TeamName, MaxNameLength)
+		e.CheckMembers(FieldPath("teams", i, "members"), team.Members)
+	}
+}
+
+// CheckPullRequest validates fields of new pull request.
+func (e *ValidationError) CheckPullRequest(id, name, author string) {
+	e.CheckID("pull_request_id", id)
+	e.CheckName("pull_request_name", name, MaxTitleLength)
+	e.CheckID("author_id", author)
+}
+
+// CheckReviewer validates pull request and reviewer to replace.
+func (e *ValidationError) CheckReviewer(r Reviewer) {
+	e.CheckID("pull_request_id", r.PRID)
+	e.CheckID("old_user_id", r.UID)
+}
+
+// CheckMove validates user moved to another team.
+func (e *ValidationError) CheckMove(userID, teamName string) {
+	e.CheckID("user_id", userID)
+	e.CheckName("team_name", teamName, MaxNameLength)
+}
+
+// FieldPath builds path to field of array element, e.g. "members.1.user_id".
+func FieldPath(array string, index int, field string) string {
+	path := fmt.Sprintf("%s.%d", array, index)
+	if len(field) != 0 {
+		path += "." + field
+	}
+	return path
+}
@@ -3,14 +3,54 @@ package model
 
 // Team represents a group of users participating in reviews.
 type Team struct {
-	TeamName string `json:"team_name"`
-	Members  []User `json:"members"`
+	TeamName string `json:"team_name" yaml:"team_name"`
+	Members  []User `json:"members" yaml:"members"`
+	Archived bool   `json:"archived,omitempty" yaml:"-"`
 }
 
 // User represents an application user and their team membership.
+// TeamName is empty for users whose team was deleted.
 type User struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name,omitempty"`
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	IsActive bool   `json:"is_active" yaml:"is_active"`
+	TeamName string `json:"team_name,omitempty" yaml:"-"`
+}
+
+// ArchivedTeam is result of archiving team with reviews its members were removed from.
+type ArchivedTeam struct {
+	Team
+
+	Affected []ReviewChange `json:"affected_pull_requests"`
+}
+
+// Roster is desired state of all teams and their members.
+type Roster struct {
+	Teams []Team `json:"teams" yaml:"teams"`
+}
+
+// Kinds of changes made by roster synchronization.
+const (
+	SyncCreateTeam     = "CREATE_TEAM"
+	SyncCreateUser     = "CREATE_USER"
+	SyncUpdateUser     = "UPDATE_USER"
+	SyncMoveUser       = "MOVE_USER"
+	SyncDeactivateUser = "DEACTIVATE_USER"
+)
+
+// SyncAction is a single change required to bring database to roster state.
+type SyncAction struct {
+	Action   string `json:"action"`
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id,omitempty"`
+	Username string `json:"username,omitempty"`
+	FromTeam string `json:"from_team,omitempty"`
+}
+
+// SyncPlan lists changes of roster synchronization.
+// Affected reviews are known only after plan is applied.
+type SyncPlan struct {
+	DryRun   bool           `json:"dry_run"`
+	Actions  []SyncAction   `json:"actions"`
+	Affected []ReviewChange `json:"affected_pull_requests"`
 }
@@ -1,15 +1,54 @@
 package model
 
+import "time"
+
 // ReviewReport summarizes pull requests reviewed by a specific user.
 type ReviewReport struct {
 	UserID       string             `json:"user_id"`
 	PullRequests []PullRequestShort `json:"pull_requests"`
+	NextCursor   string             `json:"next_cursor,omitempty"`
+}
+
+// ReviewQuery describes filtering and paging of user's review report.
+// Zero value returns all reviews in default short form.
+type ReviewQuery struct {
+	Status     string
+	Descending bool
+	Limit      int
+	Cursor     string
+	Details    bool
 }
 
 // PullRequestShort - short form of PullRequest for some responses.
+// Reviewers and timestamps are filled only on request.
 type PullRequestShort struct {
-	ID     string `json:"pull_request_id"`
-	Name   string `json:"pull_request_name"`
-	Author string `json:"author_id"`
-	Status string `json:"status"`
+	ID        string     `json:"pull_request_id"`
+	Name      string     `json:"pull_request_name"`
+	Author    string     `json:"author_id"`
+	Status    string     `json:"status"`
+	Reviewers []string   `json:"assigned_reviewers,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	MergedAt  *time.Time `json:"mergedAt,omitempty"`
+}
+
+// Actions applied to user's open reviews when user leaves team.
+const (
+	ReviewKept       = "KEPT"
+	ReviewReassigned = "REASSIGNED"
+	ReviewRemoved    = "REMOVED"
+)
+
+// ReviewChange describes what happened to user's review of open pull request.
+type ReviewChange struct {
+	PRID      string `json:"pull_request_id"`
+	OldUserID string `json:"old_user_id"`
+	NewUserID string `json:"new_user_id,omitempty"`
+	Action    string `json:"action"`
+}
+
+// MembershipChange is result of moving users into team or removing them from it.
+type MembershipChange struct {
+	TeamName string         `json:"team_name"`
+	Users    []User         `json:"users"`
+	Affected []ReviewChange `json:"affected_pull_requests"`
 }
@@ -0,0 +1,181 @@
+// Package oidc verifies bearer JWTs issued by OpenID Connect provider against its JSON Web Key Set.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// Config configures token verification.
+type Config struct {
+	// JWKS is path to key set file or its http(s) URL.
+	JWKS string
+	// Issuer and Audience are checked when not empty.
+	Issuer   string
+	Audience string
+	// UserClaim holds user_id, RolesClaim holds list of roles.
+	// Nested claims are separated by dot, e.g. "realm_access.roles".
+	UserClaim  string
+	RolesClaim string
+	// OrgClaim holds organization of engineer, it is ignored when empty.
+	OrgClaim string
+}
+
+// leeway allows small clock skew between provider and service.
+const leeway = time.Minute
+
+const maxJWKSSize = 1 << 20
+
+// signatureAlgorithms are accepted algorithms, symmetric ones are excluded since keys are public.
+//
+//nolint:gochecknoglobals // constant list
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// Verifier checks signature and standard claims of tokens.
+type Verifier struct {
+	cfg    Config
+	client *http.Client
+	keys   atomic.Pointer[jose.JSONWebKeySet]
+}
+
+// New loads key set and creates verifier.
+func New(ctx context.Context, cfg Config) (*Verifier, error) {
+	v := &Verifier{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second}, //nolint:exhaustruct,mnd // other fields keep defaults
+		keys:   atomic.Pointer[jose.JSONWebKeySet]{},
+	}
+	err := v.Refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Remote reports whether key set is loaded by URL and should be refreshed to follow key rotation.
+func (v *Verifier) Remote() bool {
+	return isURL(v.cfg.JWKS)
+}
+
+// Refresh loads key set again. Current keys are kept if loading fails.
+func (v *Verifier) Refresh(ctx context.Context) error {
+	data, err := v.load(ctx)
+	if err != nil {
+		return fmt.Errorf("load JWKS %s: %w", v.cfg.JWKS, err)
+	}
+
+	var keys jose.JSONWebKeySet
+	err = json.Unmarshal(data, &keys)
+	if err != nil {
+		return fmt.Errorf("parse JWKS %s: %w", v.cfg.JWKS, err)
+	}
+	if len(keys.Keys) == 0 {
+		return fmt.Errorf("JWKS %s has no keys", v.cfg.JWKS)
+	}
+	v.keys.Store(&keys)
+	return nil
+}
+
+func (v *Verifier) load(ctx context.Context) ([]byte, error) {
+	if !isURL(v.cfg.JWKS) {
+		return os.ReadFile(v.cfg.JWKS) // #nosec G304 - path is given by operator
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.cfg.JWKS, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := v.client.Do(req) // #nosec G107 - URL is given by operator
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// Verify checks token and returns identity it was issued for.
+// Every problem with token is reported as model.ErrUnauthorized.
+func (v *Verifier) Verify(token string) (model.Identity, error) {
+	parsed, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return model.Identity{}, fmt.Errorf("parse token: %w", model.ErrUnauthorized)
+	}
+
+	var std jwt.Claims
+	var claims map[string]any
+	err = parsed.Claims(v.keys.Load(), &std, &claims)
+	if err != nil {
+		return model.Identity{}, fmt.Errorf("verify token: %w", model.ErrUnauthorized)
+	}
+	err = std.ValidateWithLeeway(jwt.Expected{ //nolint:exhaustruct // other claims are not checked
+		Issuer:      v.cfg.Issuer,
+		AnyAudience: audience(v.cfg.Audience),
+		Time:        time.Now(),
+	}, leeway)
+	if err != nil {
+		return model.Identity{}, fmt.Errorf("validate token: %w: %w", err, model.ErrUnauthorized)
+	}
+	if std.Expiry == nil {
+		return model.Identity{}, fmt.Errorf("token has no expiration time: %w", model.ErrUnauthorized)
+	}
+
+	userID, _ := claim(claims, v.cfg.UserClaim).(string)
+	if len(userID) == 0 {
+		return model.Identity{}, fmt.Errorf("token has no %s claim: %w", v.cfg.UserClaim, model.ErrUnauthorized)
+	}
+	identity := model.Identity{Org: "", UserID: userID, Roles: nil}
+	if len(v.cfg.OrgClaim) != 0 {
+		identity.Org, _ = claim(claims, v.cfg.OrgClaim).(string)
+	}
+	roles, _ := claim(claims, v.cfg.RolesClaim).([]any)
+	for _, r := range roles {
+		if role, ok := r.(string); ok {
+			identity.Roles = append(identity.Roles, model.Role(role))
+		}
+	}
+	return identity, nil
+}
+
+func audience(aud string) jwt.Audience {
+	if len(aud) == 0 {
+		return nil
+	}
+	return jwt.Audience{aud}
+}
+
+// claim finds claim by dot separated path.
+func claim(claims map[string]any, path string) any {
+	var value any = claims
+	for name := range strings.SplitSeq(path, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[name]
+	}
+	return value
+}
@@ -0,0 +1,165 @@
+package oidc_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/oidc"
+)
+
+const (
+	issuer   = "https://sso.example.com"
+	audience = "review-manager"
+)
+
+func newKey(t *testing.T, kid string) (*rsa.PrivateKey, jose.JSONWebKeySet) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwk := jose.JSONWebKey{Key: key.Public(), KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"}
+	return key, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk}}
+}
+
+func writeJWKS(t *testing.T, keys jose.JSONWebKeySet) string {
+	t.Helper()
+	data, err := json.Marshal(keys)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func sign(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid))
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func claims(overrides map[string]any) map[string]any {
+	c := map[string]any{
+		"iss":   issuer,
+		"aud":   audience,
+		"sub":   "u1",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"team_lead"},
+		"org":   "payments",
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(c, k)
+		} else {
+			c[k] = v
+		}
+	}
+	return c
+}
+
+func newVerifier(t *testing.T, jwks string) *oidc.Verifier {
+	t.Helper()
+	v, err := oidc.New(t.Context(), oidc.Config{
+		JWKS: jwks, Issuer: issuer, Audience: audience, UserClaim: "sub", RolesClaim: "roles", OrgClaim: "org",
+	})
+	require.NoError(t, err)
+	return v
+}
+
+func TestVerify(t *testing.T) {
+	key, keys := newKey(t, "k1")
+	other, _ := newKey(t, "k1")
+	v := newVerifier(t, writeJWKS(t, keys))
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{name: "valid", token: sign(t, key, "k1", claims(nil)), valid: true},
+		{name: "expired", token: sign(t, key, "k1", claims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))},
+		{name: "no expiration", token: sign(t, key, "k1", claims(map[string]any{"exp": nil}))},
+		{name: "other issuer", token: sign(t, key, "k1", claims(map[string]any{"iss": "https://evil.example.com"}))},
+		{name: "other audience", token: sign(t, key, "k1", claims(map[string]any{"aud": "billing"}))},
+		{name: "no subject", token: sign(t, key, "k1", claims(map[string]any{"sub": nil}))},
+		{name: "unknown key", token: sign(t, other, "k1", claims(nil))},
+		{name: "malformed", token: "not.a.jwt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := v.Verify(tt.token)
+			if tt.valid {
+				require.NoError(t, err)
+				assert.Equal(t, model.Identity{
+					Org: "payments", UserID: "u1", Roles: []model.Role{model.RoleTeamLead},
+				}, identity)
+			} else {
+				require.ErrorIs(t, err, model.ErrUnauthorized)
+			}
+		})
+	}
+}
+
+func TestVerify_NestedClaims(t *testing.T) {
+	key, keys := newKey(t, "k1")
+	v, err := oidc.New(t.Context(), oidc.Config{
+		JWKS: writeJWKS(t, keys), Issuer: "", Audience: "",
+		UserClaim: "preferred_username", RolesClaim: "realm_access.roles", OrgClaim: "tenant.id",
+	})
+	require.NoError(t, err)
+
+	identity, err := v.Verify(sign(t, key, "k1", claims(map[string]any{
+		"preferred_username": "alice",
+		"realm_access":       map[string]any{"roles": []string{"admin", "offline_access"}},
+		"tenant":             map[string]any{"id": "platform"},
+	})))
+
+	require.NoError(t, err)
+	assert.Equal(t, "alice", identity.UserID)
+	assert.Equal(t, model.RoleAdmin, identity.Role())
+	assert.Equal(t, "platform", identity.Org)
+}
+
+func TestRefresh_URL(t *testing.T) {
+	oldKey, oldKeys := newKey(t, "old")
+	rotatedKey, rotatedKeys := newKey(t, "new")
+	current := oldKeys
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(current)
+	}))
+	defer server.Close()
+	v := newVerifier(t, server.URL)
+	assert.True(t, v.Remote())
+
+	// Provider rotates keys.
+	current = rotatedKeys
+	_, err := v.Verify(sign(t, rotatedKey, "new", claims(nil)))
+	require.ErrorIs(t, err, model.ErrUnauthorized)
+
+	require.NoError(t, v.Refresh(t.Context()))
+	_, err = v.Verify(sign(t, rotatedKey, "new", claims(nil)))
+	require.NoError(t, err)
+	_, err = v.Verify(sign(t, oldKey, "old", claims(nil)))
+	require.ErrorIs(t, err, model.ErrUnauthorized)
+}
+
+func TestNew_InvalidJWKS(t *testing.T) {
+	_, err := oidc.New(t.Context(), oidc.Config{
+		JWKS: filepath.Join(t.TempDir(), "missing.json"), Issuer: "", Audience: "", UserClaim: "sub", RolesClaim: "roles",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// APIKey is database repository for API keys.
+type APIKey struct {
+	Pool *pgxpool.Pool
+}
+
+const apiKeyColumns = `key_id, name, org_id, scopes, created_at, revoked_at`
+
+// Add saves key of organization from context with hash of its token and returns it with creation time.
+func (r *APIKey) Add(ctx context.Context, key model.APIKey, hash []byte) (model.APIKey, error) {
+	row := database.Conn(ctx, r.Pool).QueryRow(ctx, `
+		INSERT INTO ApiKey (key_id, name, org_id, key_hash, scopes)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+apiKeyColumns+`;
+	`, key.ID, key.Name, model.OrgFrom(ctx), hash, scopeStrings(key.Scopes))
+	return scanAPIKey(row)
+}
+
+// GetByHash finds key of any organization by hash of its token, revoked keys are returned too.
+func (r *APIKey) GetByHash(ctx context.Context, hash []byte) (model.APIKey, error) {
+	row := database.Conn(ctx, r.Pool).QueryRow(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM ApiKey
+		WHERE key_hash = $1;
+	`, hash)
+	return scanAPIKey(row)
+}
+
+// List returns every key of organization ordered by creation time.
+func (r *APIKey) List(ctx context.Context) ([]model.APIKey, error) {
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM ApiKey
+		WHERE org_id = $1
+		ORDER BY created_at, key_id;
+	`, model.OrgFrom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []model.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// Revoke marks key as revoked, revoking key again keeps the first revocation time.
+func (r *APIKey) Revoke(ctx context.Context, id string) (model.APIKey, error) {
+	row := database.Conn(ctx, r.Pool).QueryRow(ctx, `
+		UPDATE ApiKey
+		SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE org_id = $1 AND key_id = $2
+		RETURNING `+apiKeyColumns+`;
+	`, model.OrgFrom(ctx), id)
+	return scanAPIKey(row)
+}
+
+func scanAPIKey(row pgx.Row) (model.APIKey, error) {
+	var key model.APIKey
+	var scopes []string
+	err := row.Scan(&key.ID, &key.Name, &key.Org, &scopes, &key.CreatedAt, &key.RevokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.APIKey{}, model.ErrNotFound
+	}
+	if err != nil {
+		return model.APIKey{}, err
+	}
+	key.Scopes = make([]model.Scope, 0, len(scopes))
+	for _, s := range scopes {
+		key.Scopes = append(key.Scopes, model.Scope(s))
+	}
+	return key, nil
+}
+
+func scopeStrings(scopes []model.Scope) []string {
+	result := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		result = append(result, string(s))
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// EventsChannel is channel of PostgreSQL notifications with assignment events.
+const EventsChannel = "assignment_events"
+
+// maxNotifyPayload is longest payload of NOTIFY, PostgreSQL rejects payloads of 8000 bytes and longer.
+const maxNotifyPayload = 7999
+
+// Events publishes assignment events with NOTIFY, so every server listening to the channel receives them.
+type Events struct {
+	Pool *pgxpool.Pool
+}
+
+// Publish sends events in as few notifications as payload limit allows. Inside transaction
+// notifications are delivered when transaction is committed and dropped when it is rolled back.
+func (r *Events) Publish(ctx context.Context, events ...model.AssignmentEvent) error {
+	payloads, err := notifyPayloads(events)
+	if err != nil {
+		return err
+	}
+	for _, payload := range payloads {
+		_, err = database.Conn(ctx, r.Pool).Exec(ctx, `SELECT pg_notify($1, $2)`, EventsChannel, payload)
+		if err != nil {
+			return fmt.Errorf("notify %s: %w", EventsChannel, err)
+		}
+	}
+	return nil
+}
+
+// notifyPayloads splits events into JSON arrays that fit into NOTIFY payload.
+func notifyPayloads(events []model.AssignmentEvent) ([]string, error) {
+	var payloads []string
+	var chunk bytes.Buffer
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		if len(data)+len("[]") > maxNotifyPayload {
+			return nil, fmt.Errorf("event of %s is %d bytes, longer than NOTIFY payload", e.PRID, len(data))
+		}
+		if chunk.Len() != 0 && chunk.Len()+len(",]")+len(data) > maxNotifyPayload {
+			payloads = append(payloads, chunk.String()+"]")
+			chunk.Reset()
+		}
+		if chunk.Len() == 0 {
+			chunk.WriteByte('[')
+		} else {
+			chunk.WriteByte(',')
+		}
+		chunk.Write(data)
+	}
+	if chunk.Len() != 0 {
+		payloads = append(payloads, chunk.String()+"]")
+	}
+	return payloads, nil
+}
+
+// Listen passes events published by any server to handle until ctx is done or connection fails.
+// Connection is taken from pool for good and closed on return.
+func (r *Events) Listen(ctx context.Context, handle func(events ...model.AssignmentEvent)) error {
+	pooled, err := r.Pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn := pooled.Hijack()
+	defer func() { _ = conn.Close(context.WithoutCancel(ctx)) }()
+
+	_, err = conn.Exec(ctx, "LISTEN "+EventsChannel)
+	if err != nil {
+		return fmt.Errorf("listen %s: %w", EventsChannel, err)
+	}
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var events []model.AssignmentEvent
+		err = json.Unmarshal([]byte(notification.Payload), &events)
+		if err != nil {
+			slog.WarnContext(ctx, "Skipped malformed assignment events", slog.Any("err", err))
+			continue
+		}
+		handle(events...)
+	}
+}
@@ -0,0 +1,45 @@
+package repository_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/repository"
+)
+
+func TestNotifyPayloads(t *testing.T) {
+	events := make([]model.AssignmentEvent, 0, 200)
+	for i := range 200 {
+		events = append(events, model.AssignmentEvent{
+			Type: model.AssignmentReassigned, Org: model.DefaultOrg, PRID: fmt.Sprintf("pr-%d", i), AuthorID: "u1",
+			TeamName: "backend", ReviewerID: "u3", PreviousID: "u2", Time: time.Now().UTC(),
+		})
+	}
+	all, err := json.Marshal(events)
+	require.NoError(t, err)
+	require.Greater(t, len(all), 8000)
+
+	payloads, err := repository.NotifyPayloads(events)
+	require.NoError(t, err)
+	assert.Greater(t, len(payloads), 1)
+
+	// Every payload fits into NOTIFY and events keep their order.
+	var got []model.AssignmentEvent
+	for _, payload := range payloads {
+		assert.LessOrEqual(t, len(payload), repository.MaxNotifyPayload)
+		var chunk []model.AssignmentEvent
+		require.NoError(t, json.Unmarshal([]byte(payload), &chunk))
+		got = append(got, chunk...)
+	}
+	assert.Equal(t, events, got)
+
+	payloads, err = repository.NotifyPayloads(nil)
+	require.NoError(t, err)
+	assert.Empty(t, payloads)
+}
@@ -0,0 +1,9 @@
+package repository
+
+// Exported for tests in repository_test package.
+//
+//nolint:gochecknoglobals
+var (
+	NotifyPayloads   = notifyPayloads
+	MaxNotifyPayload = maxNotifyPayload
+)
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Idempotency is database repository for responses of requests sent with idempotency keys.
+type Idempotency struct {
+	Pool *pgxpool.Pool
+}
+
+// Reserve saves request hash under key, unless key is already used. Keys older than ttl are reused.
+// Requests without response that hold key longer than lease are taken over, as their server
+// must have died before releasing the key. Zero lease keeps such keys until ttl.
+// Returns true if request is reserved, otherwise returns request saved under key before.
+func (r *Idempotency) Reserve(
+	ctx context.Context, key model.IdempotencyKey, hash []byte, ttl, lease time.Duration,
+) (model.IdempotentRequest, bool, error) {
+	if lease <= 0 {
+		lease = ttl
+	}
+	conn := database.Conn(ctx, r.Pool)
+	tag, err := conn.Exec(ctx, `
+		INSERT INTO IdempotencyKey (idempotency_key, route, request_hash)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (idempotency_key, route) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, status_code = NULL, content_type = NULL,
+			response_body = NULL, created_at = NOW()
+		WHERE IdempotencyKey.created_at < NOW() - make_interval(secs => $4)
+			OR (IdempotencyKey.status_code IS NULL AND IdempotencyKey.created_at < NOW() - make_interval(secs => $5));
+	`, key.Key, key.Route, hash, ttl.Seconds(), lease.Seconds())
+	if err != nil {
+		return model.IdempotentRequest{}, false, err
+	}
+	if tag.RowsAffected() != 0 {
+		return model.IdempotentRequest{}, true, nil
+	}
+
+	var req model.IdempotentRequest
+	var status *int
+	var contentType *string
+	var body []byte
+	err = conn.QueryRow(ctx, `
+		SELECT request_hash, status_code, content_type, response_body
+		FROM IdempotencyKey
+		WHERE idempotency_key = $1 AND route = $2;
+	`, key.Key, key.Route).Scan(&req.Hash, &status, &contentType, &body)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Original request failed and released the key right now, client should retry.
+		return model.IdempotentRequest{}, false, model.ErrRequestInProgress
+	} else if err != nil {
+		return model.IdempotentRequest{}, false, err
+	}
+
+	if status != nil {
+		req.Response = &model.StoredResponse{Status: *status, ContentType: "", Body: body}
+		if contentType != nil {
+			req.Response.ContentType = *contentType
+		}
+	}
+	return req, false, nil
+}
+
+// Complete saves response of reserved request.
+func (r *Idempotency) Complete(ctx context.Context, key model.IdempotencyKey, resp model.StoredResponse) error {
+	_, err := database.Conn(ctx, r.Pool).Exec(ctx, `
+		UPDATE IdempotencyKey
+		SET status_code = $3, content_type = $4, response_body = $5
+		WHERE idempotency_key = $1 AND route = $2;
+	`, key.Key, key.Route, resp.Status, resp.ContentType, resp.Body)
+	return err
+}
+
+// Release frees reserved key that has no response, so the request can be retried.
+func (r *Idempotency) Release(ctx context.Context, key model.IdempotencyKey) error {
+	_, err := database.Conn(ctx, r.Pool).Exec(ctx, `
+		DELETE FROM IdempotencyKey
+		WHERE idempotency_key = $1 AND route = $2 AND status_code IS NULL;
+	`, key.Key, key.Route)
+	return err
+}
+
+// DeleteExpired removes keys older than ttl and returns number of removed keys.
+func (r *Idempotency) DeleteExpired(ctx context.Context, ttl time.Duration) (int64, error) {
+	tag, err := database.Conn(ctx, r.Pool).Exec(ctx, `
+		DELETE FROM IdempotencyKey
+		WHERE created_at < NOW() - make_interval(secs => $1);
+	`, ttl.Seconds())
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/LeonovDS/review-manager/internal/database"
 	"github.com/LeonovDS/review-manager/internal/model"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -21,12 +24,12 @@ func (r *PullRequest) Create(
 	prID, prName, author string,
 ) (model.PullRequest, error) {
 	var pr model.PullRequest
-	err := r.Pool.QueryRow(ctx, `
-		INSERT INTO PullRequest (pull_request_id, pull_request_name, author_id, status)	
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (pull_request_id) DO NOTHING
+	err := database.Conn(ctx, r.Pool).QueryRow(ctx, `
+		INSERT INTO PullRequest (org_id, pull_request_id, pull_request_name, author_id, status)	
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (org_id, pull_request_id) DO NOTHING
 		RETURNING pull_request_id, pull_request_name, author_id, status, created_at;
-	`, prID, prName, author, "OPEN").Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt)
+	`, model.OrgFrom(ctx), prID, prName, author, "OPEN").Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt)
 
 	if errors.Is(err, pgx.ErrNoRows) {
 		return model.PullRequest{}, model.ErrPRExists
@@ -37,16 +40,18 @@ func (r *PullRequest) Create(
 }
 
 // AssignReviewers adds reviewers to pull requests WITHOUT ADDITIONAL CHECKS.
+// Only foreign keys guarantee that pull request and reviewers belong to the same organization.
 func (r *PullRequest) AssignReviewers(ctx context.Context, prID string, reviewers []string) error {
 	var batch pgx.Batch
 	query := `
-		INSERT INTO UsersToPullRequests (pull_request_id, reviewer_id)
-		VALUES ($1, $2);
+		INSERT INTO UsersToPullRequests (org_id, pull_request_id, reviewer_id)
+		VALUES ($1, $2, $3);
 	`
+	org := model.OrgFrom(ctx)
 	for _, rID := range reviewers {
-		batch.Queue(query, prID, rID)
+		batch.Queue(query, org, prID, rID)
 	}
-	br := r.Pool.SendBatch(ctx, &batch)
+	br := database.Conn(ctx, r.Pool).SendBatch(ctx, &batch)
 	defer func() { _ = br.Close() }()
 	for range reviewers {
 		_, err := br.Exec()
@@ -60,12 +65,13 @@ func (r *PullRequest) AssignReviewers(ctx context.Context, prID string, reviewer
 // Merge updates pull request status.
 // Cannot separate cases when PR is not found or not updated, so needs additional checks on call side.
 func (r *PullRequest) Merge(ctx context.Context, id string) error {
-	tag, err := r.Pool.Exec(ctx, `
+	tag, err := database.Conn(ctx, r.Pool).Exec(ctx, `
 		UPDATE PullRequest
 		SET status = 'MERGED', merged_at = NOW()
-		WHERE pull_request_id = $1 
+		WHERE org_id = $1
+		AND pull_request_id = $2 
 		AND status = 'OPEN';
-	`, id)
+	`, model.OrgFrom(ctx), id)
 	if err != nil {
 		return err
 	}
@@ -83,14 +89,14 @@ func (r *PullRequest) Get(ctx context.Context, id string) (model.PullRequest, er
 			COALESCE(array_agg(rev.reviewer_id) FILTER (WHERE rev.reviewer_id IS NOT NULL), '{}') AS reviewers
 		FROM PullRequest pr
 		LEFT JOIN UsersToPullRequests rev 
-			ON rev.pull_request_id = pr.pull_request_id
-		WHERE pr.pull_request_id = $1
-		GROUP BY pr.pull_request_id;
+			ON rev.org_id = pr.org_id AND rev.pull_request_id = pr.pull_request_id
+		WHERE pr.org_id = $1 AND pr.pull_request_id = $2
+		GROUP BY pr.org_id, pr.pull_request_id;
 	`
 
 	var pr model.PullRequest
 	var mergedAt *time.Time
-	err := r.Pool.QueryRow(ctx, query, id).Scan(
+	err := database.Conn(ctx, r.Pool).QueryRow(ctx, query, model.OrgFrom(ctx), id).Scan(
 		&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &mergedAt, &pr.Reviewers)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return model.PullRequest{}, model.ErrNotFound
@@ -106,47 +112,229 @@ func (r *PullRequest) Get(ctx context.Context, id string) (model.PullRequest, er
 func (r *PullRequest) UpdateReviewer(ctx context.Context, prID, oUID, nUID string) error {
 	query := `
 		UPDATE UsersToPullRequests
-		SET reviewer_id = $3
-		WHERE pull_request_id = $1 
-			AND reviewer_id = $2;
+		SET reviewer_id = $4
+		WHERE org_id = $1
+			AND pull_request_id = $2 
+			AND reviewer_id = $3;
 	`
-	_, err := r.Pool.Exec(ctx, query, prID, oUID, nUID)
+	_, err := database.Conn(ctx, r.Pool).Exec(ctx, query, model.OrgFrom(ctx), prID, oUID, nUID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// GetByUserID finds all pull request reviewed by user with id uID.
-func (r *PullRequest) GetByUserID(
-	ctx context.Context, uID string,
-) ([]model.PullRequestShort, error) {
-	query := `
-		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
+// List finds pull requests matching filter ordered by creation time.
+// Non-positive limit means no limit.
+func (r *PullRequest) List(
+	ctx context.Context, filter model.PullRequestFilter,
+) ([]model.PullRequest, error) {
+	conds, args := prFilterConditions(model.OrgFrom(ctx), filter)
+	cmp, order := ">", "ASC"
+	if filter.Descending {
+		cmp, order = "<", "DESC"
+	}
+	if filter.After != nil {
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+		conds = append(conds, fmt.Sprintf(
+			"(pr.created_at, pr.pull_request_id) %s ($%d, $%d)", cmp, len(args)-1, len(args)))
+	}
+	limit := "ALL"
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		limit = fmt.Sprintf("$%d", len(args))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at,
+			COALESCE(array_agg(rev.reviewer_id) FILTER (WHERE rev.reviewer_id IS NOT NULL), '{}') AS reviewers
 		FROM PullRequest pr
 		LEFT JOIN UsersToPullRequests rev
-			ON pr.pull_request_id = rev.pull_request_id
-		WHERE rev.reviewer_id = $1;
-	`
-	rows, err := r.Pool.Query(ctx, query, uID)
+			ON rev.org_id = pr.org_id AND rev.pull_request_id = pr.pull_request_id
+		WHERE %[1]s
+		GROUP BY pr.org_id, pr.pull_request_id
+		ORDER BY pr.created_at %[2]s, pr.pull_request_id %[2]s
+		LIMIT %[3]s;
+	`, strings.Join(conds, " AND "), order, limit)
+
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, query, args...)
 	if err != nil {
-		return []model.PullRequestShort{}, err
+		return []model.PullRequest{}, err
 	}
+	defer rows.Close()
 
-	res := make([]model.PullRequestShort, 0, 1)
+	res := make([]model.PullRequest, 0, max(filter.Limit, 1))
 	for rows.Next() {
-		var pr model.PullRequestShort
-		err := rows.Scan(&pr.ID, &pr.Name, &pr.Author, &pr.Status)
+		var pr model.PullRequest
+		err := rows.Scan(
+			&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.Reviewers)
 		if err != nil {
-			return []model.PullRequestShort{}, err
+			return []model.PullRequest{}, err
 		}
 		res = append(res, pr)
 	}
 
 	err = rows.Err()
 	if err != nil {
-		return []model.PullRequestShort{}, err
+		return []model.PullRequest{}, err
 	}
-
 	return res, nil
 }
+
+// Count returns number of pull requests matching filter, ignoring cursor and limit.
+func (r *PullRequest) Count(ctx context.Context, filter model.PullRequestFilter) (int, error) {
+	conds, args := prFilterConditions(model.OrgFrom(ctx), filter)
+	query := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM PullRequest pr
+		WHERE %s;
+	`, strings.Join(conds, " AND "))
+
+	var total int
+	err := database.Conn(ctx, r.Pool).QueryRow(ctx, query, args...).Scan(&total)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// CountOpenByTeam returns number of open pull requests authored or reviewed by team members.
+func (r *PullRequest) CountOpenByTeam(ctx context.Context, teamName string) (int, error) {
+	query := `
+		SELECT COUNT(DISTINCT pr.pull_request_id)
+		FROM PullRequest pr
+		LEFT JOIN UsersToPullRequests rev
+			ON rev.org_id = pr.org_id AND rev.pull_request_id = pr.pull_request_id
+		JOIN Users u
+			ON u.org_id = pr.org_id AND (u.user_id = pr.author_id OR u.user_id = rev.reviewer_id)
+		WHERE pr.org_id = $1
+			AND pr.status = 'OPEN'
+			AND u.team = $2;
+	`
+	var count int
+	err := database.Conn(ctx, r.Pool).QueryRow(ctx, query, model.OrgFrom(ctx), teamName).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountOpenReviews returns number of open pull requests assigned to each reviewer of every organization.
+func (r *PullRequest) CountOpenReviews(ctx context.Context) (map[model.OrgUser]int, error) {
+	query := `
+		SELECT rev.org_id, rev.reviewer_id, COUNT(*)
+		FROM UsersToPullRequests rev
+		JOIN PullRequest pr
+			ON pr.org_id = rev.org_id AND pr.pull_request_id = rev.pull_request_id
+		WHERE pr.status = 'OPEN'
+		GROUP BY rev.org_id, rev.reviewer_id;
+	`
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[model.OrgUser]int)
+	for rows.Next() {
+		var user model.OrgUser
+		var count int
+		err = rows.Scan(&user.Org, &user.UserID, &count)
+		if err != nil {
+			return nil, err
+		}
+		counts[user] = count
+	}
+	return counts, rows.Err()
+}
+
+// prFilterConditions builds WHERE conditions and positional arguments for filter within organization.
+func prFilterConditions(org string, filter model.PullRequestFilter) ([]string, []any) {
+	conds := []string{"pr.org_id = $1"}
+	args := []any{org}
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.Status != "" {
+		add("pr.status = $%d", filter.Status)
+	}
+	if filter.AuthorID != "" {
+		add("pr.author_id = $%d", filter.AuthorID)
+	}
+	if filter.ReviewerID != "" {
+		add(`EXISTS (
+			SELECT 1 FROM UsersToPullRequests r
+			WHERE r.org_id = pr.org_id AND r.pull_request_id = pr.pull_request_id AND r.reviewer_id = $%d)`, filter.ReviewerID)
+	}
+	if filter.TeamName != "" {
+		add(`EXISTS (
+			SELECT 1 FROM Users u
+			WHERE u.org_id = pr.org_id AND u.user_id = pr.author_id AND u.team = $%d)`, filter.TeamName)
+	}
+	if filter.Name != "" {
+		add("strpos(lower(pr.pull_request_name), lower($%d)) > 0", filter.Name)
+	}
+	if filter.CreatedFrom != nil {
+		add("pr.created_at >= $%d", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		add("pr.created_at < $%d", *filter.CreatedTo)
+	}
+	if filter.MergedFrom != nil {
+		add("pr.merged_at >= $%d", *filter.MergedFrom)
+	}
+	if filter.MergedTo != nil {
+		add("pr.merged_at < $%d", *filter.MergedTo)
+	}
+	return conds, args
+}
+
+// RemoveReviewer unassigns reviewer from pull request.
+func (r *PullRequest) RemoveReviewer(ctx context.Context, prID, uID string) error {
+	query := `
+		DELETE FROM UsersToPullRequests
+		WHERE org_id = $1
+			AND pull_request_id = $2
+			AND reviewer_id = $3;
+	`
+	_, err := database.Conn(ctx, r.Pool).Exec(ctx, query, model.OrgFrom(ctx), prID, uID)
+	return err
+}
+
+// ForEach calls fn for every pull request with reviewers ordered by creation time,
+// stopping on first error.
+func (r *PullRequest) ForEach(ctx context.Context, fn func(model.PullRequest) error) error {
+	query := `
+		SELECT
+			pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at,
+			COALESCE(array_agg(rev.reviewer_id) FILTER (WHERE rev.reviewer_id IS NOT NULL), '{}') AS reviewers
+		FROM PullRequest pr
+		LEFT JOIN UsersToPullRequests rev
+			ON rev.org_id = pr.org_id AND rev.pull_request_id = pr.pull_request_id
+		WHERE pr.org_id = $1
+		GROUP BY pr.org_id, pr.pull_request_id
+		ORDER BY pr.created_at, pr.pull_request_id;
+	`
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, query, model.OrgFrom(ctx))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pr model.PullRequest
+		err := rows.Scan(
+			&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.Reviewers)
+		if err != nil {
+			return err
+		}
+		err = fn(pr)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
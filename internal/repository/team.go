@@ -6,8 +6,11 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/LeonovDS/review-manager/internal/database"
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,31 +20,33 @@ type Team struct {
 }
 
 // Add saves team to database or returns error, if team exists.
+// Like every query of repositories, it works within organization from context.
 func (r *Team) Add(ctx context.Context, team model.Team) (model.Team, error) {
 	var name string
-	err := r.Pool.QueryRow(ctx, `
-		INSERT INTO Team (name) 
-		VALUES ($1) 
-		ON CONFLICT (name) DO NOTHING 
+	err := database.Conn(ctx, r.Pool).QueryRow(ctx, `
+		INSERT INTO Team (org_id, name) 
+		VALUES ($1, $2) 
+		ON CONFLICT (org_id, name) DO NOTHING 
 		RETURNING name;
-	`, team.TeamName).Scan(&name)
+	`, model.OrgFrom(ctx), team.TeamName).Scan(&name)
 
 	if errors.Is(err, pgx.ErrNoRows) {
 		return model.Team{}, fmt.Errorf("%s %w", team.TeamName, model.ErrTeamExists)
 	} else if err != nil {
 		return model.Team{}, err
 	}
-	return model.Team{TeamName: name, Members: []model.User{}}, nil
+	return model.Team{TeamName: name, Members: []model.User{}, Archived: false}, nil
 }
 
 // Get searches database for team with given name.
 func (r *Team) Get(ctx context.Context, name string) (model.Team, error) {
 	var dbName string
-	err := r.Pool.QueryRow(ctx, `
-		SELECT (name) 
+	var archived bool
+	err := database.Conn(ctx, r.Pool).QueryRow(ctx, `
+		SELECT name, archived
 		FROM Team 
-		WHERE name=$1;
-	`, name).Scan(&dbName)
+		WHERE org_id = $1 AND name = $2;
+	`, model.OrgFrom(ctx), name).Scan(&dbName, &archived)
 
 	if errors.Is(err, pgx.ErrNoRows) {
 		return model.Team{}, fmt.Errorf("%s %w", name, model.ErrNotFound)
@@ -49,5 +54,86 @@ func (r *Team) Get(ctx context.Context, name string) (model.Team, error) {
 		return model.Team{}, err
 	}
 
-	return model.Team{TeamName: name, Members: []model.User{}}, nil
+	return model.Team{TeamName: name, Members: []model.User{}, Archived: archived}, nil
+}
+
+// Rename changes team name, members follow it via ON UPDATE CASCADE.
+func (r *Team) Rename(ctx context.Context, oldName, newName string) error {
+	tag, err := database.Conn(ctx, r.Pool).Exec(ctx, `
+		UPDATE Team
+		SET name = $3
+		WHERE org_id = $1 AND name = $2;
+	`, model.OrgFrom(ctx), oldName, newName)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+		return fmt.Errorf("%s %w", newName, model.ErrTeamExists)
+	} else if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s %w", oldName, model.ErrNotFound)
+	}
+	return nil
+}
+
+// Archive marks team as archived.
+func (r *Team) Archive(ctx context.Context, name string) error {
+	tag, err := database.Conn(ctx, r.Pool).Exec(ctx, `
+		UPDATE Team
+		SET archived = true
+		WHERE org_id = $1 AND name = $2;
+	`, model.OrgFrom(ctx), name)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s %w", name, model.ErrNotFound)
+	}
+	return nil
+}
+
+// Delete removes team, remaining members are left without team.
+func (r *Team) Delete(ctx context.Context, name string) error {
+	tag, err := database.Conn(ctx, r.Pool).Exec(ctx, `
+		DELETE FROM Team
+		WHERE org_id = $1 AND name = $2;
+	`, model.OrgFrom(ctx), name)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s %w", name, model.ErrNotFound)
+	}
+	return nil
+}
+
+// List returns all teams without members.
+func (r *Team) List(ctx context.Context) ([]model.Team, error) {
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, `
+		SELECT name, archived
+		FROM Team
+		WHERE org_id = $1
+		ORDER BY name;
+	`, model.OrgFrom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := []model.Team{}
+	for rows.Next() {
+		team := model.Team{TeamName: "", Members: []model.User{}, Archived: false}
+		err := rows.Scan(&team.TeamName, &team.Archived)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return teams, nil
 }
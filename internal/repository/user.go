@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/LeonovDS/review-manager/internal/database"
 	"github.com/LeonovDS/review-manager/internal/model"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -17,17 +18,18 @@ type User struct {
 // Add saves users to database.
 func (r *User) Add(ctx context.Context, t model.Team) error {
 	query := `
-		INSERT INTO Users (user_id, username, is_active, team)
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (user_id) DO UPDATE 
+		INSERT INTO Users (org_id, user_id, username, is_active, team)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (org_id, user_id) DO UPDATE 
 		SET username = EXCLUDED.username, is_active = EXCLUDED.is_active, team = EXCLUDED.team;
 	`
 
+	org := model.OrgFrom(ctx)
 	var batch pgx.Batch
 	for _, u := range t.Members {
-		batch.Queue(query, u.UserID, u.Username, u.IsActive, t.TeamName)
+		batch.Queue(query, org, u.UserID, u.Username, u.IsActive, t.TeamName)
 	}
-	br := r.Pool.SendBatch(ctx, &batch)
+	br := database.Conn(ctx, r.Pool).SendBatch(ctx, &batch)
 	defer func() { _ = br.Close() }()
 
 	for range t.Members {
@@ -44,9 +46,9 @@ func (r *User) GetByTeam(ctx context.Context, teamName string) ([]model.User, er
 	query := `
 		SELECT user_id, username, is_active, team
 		FROM Users 
-		WHERE team = $1;
+		WHERE org_id = $1 AND team = $2;
 	`
-	rows, err := r.Pool.Query(ctx, query, teamName)
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, query, model.OrgFrom(ctx), teamName)
 	if err != nil {
 		return []model.User{}, err
 	}
@@ -72,12 +74,12 @@ func (r *User) GetByTeam(ctx context.Context, teamName string) ([]model.User, er
 // Get find user or returns error if user is missing.
 func (r *User) Get(ctx context.Context, id string) (model.User, error) {
 	query := `
-		SELECT user_id, username, is_active, team 
+		SELECT user_id, username, is_active, COALESCE(team, '')
 		FROM Users 
-		WHERE user_id = $1;
+		WHERE org_id = $1 AND user_id = $2;
 	`
 	var user model.User
-	err := r.Pool.QueryRow(ctx, query, id).Scan(
+	err := database.Conn(ctx, r.Pool).QueryRow(ctx, query, model.OrgFrom(ctx), id).Scan(
 		&user.UserID, &user.Username, &user.IsActive, &user.TeamName)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return model.User{}, model.ErrNotFound
@@ -89,16 +91,21 @@ func (r *User) Get(ctx context.Context, id string) (model.User, error) {
 }
 
 // GetActiveTeamMembers finds other active users from the same team.
+// Members of archived teams and users of other organizations are never returned.
 func (r *User) GetActiveTeamMembers(ctx context.Context, user model.User) ([]string, error) {
 	query := `
-		SELECT user_id 
-		FROM Users 
-		WHERE team = $1 
-			AND user_id <> $2
-			AND is_active;
+		SELECT u.user_id
+		FROM Users u
+		JOIN Team t
+			ON t.org_id = u.org_id AND t.name = u.team
+		WHERE u.org_id = $1
+			AND u.team = $2
+			AND u.user_id <> $3
+			AND u.is_active
+			AND NOT t.archived;
 	`
 	var teams []string
-	rows, err := r.Pool.Query(ctx, query, user.TeamName, user.UserID)
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, query, model.OrgFrom(ctx), user.TeamName, user.UserID)
 	if err != nil {
 		return teams, err
 	}
@@ -123,10 +130,10 @@ func (r *User) GetActiveTeamMembers(ctx context.Context, user model.User) ([]str
 func (r *User) SetIsActive(ctx context.Context, uID string, isActive bool) error {
 	query := `
 		UPDATE Users
-		SET is_active = $2
-		WHERE user_id = $1;
+		SET is_active = $3
+		WHERE org_id = $1 AND user_id = $2;
 	`
-	cmd, err := r.Pool.Exec(ctx, query, uID, isActive)
+	cmd, err := database.Conn(ctx, r.Pool).Exec(ctx, query, model.OrgFrom(ctx), uID, isActive)
 	if err != nil {
 		return err
 	}
@@ -136,3 +143,118 @@ func (r *User) SetIsActive(ctx context.Context, uID string, isActive bool) error
 
 	return nil
 }
+
+// DeactivateTeam sets is_active to false for all members of team.
+func (r *User) DeactivateTeam(ctx context.Context, teamName string) error {
+	query := `
+		UPDATE Users
+		SET is_active = false
+		WHERE org_id = $1 AND team = $2;
+	`
+	_, err := database.Conn(ctx, r.Pool).Exec(ctx, query, model.OrgFrom(ctx), teamName)
+	return err
+}
+
+// MoveTeam moves all members of one team to another.
+func (r *User) MoveTeam(ctx context.Context, from, to string) error {
+	query := `
+		UPDATE Users
+		SET team = $3
+		WHERE org_id = $1 AND team = $2;
+	`
+	_, err := database.Conn(ctx, r.Pool).Exec(ctx, query, model.OrgFrom(ctx), from, to)
+	return err
+}
+
+// SetTeam moves user to another team.
+func (r *User) SetTeam(ctx context.Context, uID, teamName string) error {
+	query := `
+		UPDATE Users
+		SET team = $3
+		WHERE org_id = $1 AND user_id = $2;
+	`
+	cmd, err := database.Conn(ctx, r.Pool).Exec(ctx, query, model.OrgFrom(ctx), uID, teamName)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// RemoveFromTeam leaves user without team and deactivates it.
+func (r *User) RemoveFromTeam(ctx context.Context, uID string) error {
+	query := `
+		UPDATE Users
+		SET team = NULL, is_active = false
+		WHERE org_id = $1 AND user_id = $2;
+	`
+	cmd, err := database.Conn(ctx, r.Pool).Exec(ctx, query, model.OrgFrom(ctx), uID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// List returns all users ordered by id.
+func (r *User) List(ctx context.Context) ([]model.User, error) {
+	query := `
+		SELECT user_id, username, is_active, COALESCE(team, '')
+		FROM Users
+		WHERE org_id = $1
+		ORDER BY user_id;
+	`
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, query, model.OrgFrom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []model.User{}
+	for rows.Next() {
+		var user model.User
+		err := rows.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// ForEach calls fn for every user ordered by team and id, stopping on first error.
+func (r *User) ForEach(ctx context.Context, fn func(model.User) error) error {
+	query := `
+		SELECT user_id, username, is_active, COALESCE(team, '')
+		FROM Users
+		WHERE org_id = $1
+		ORDER BY team, user_id;
+	`
+	rows, err := database.Conn(ctx, r.Pool).Query(ctx, query, model.OrgFrom(ctx))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user model.User
+		err := rows.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName)
+		if err != nil {
+			return err
+		}
+		err = fn(user)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// authenticator finds actor by bearer token sent in authorization metadata.
+type authenticator interface {
+	Authenticate(ctx context.Context, token string) (model.Actor, error)
+}
+
+// requiredScope returns scope required by method, the same as by HTTP operation it mirrors.
+func requiredScope(method string) model.Scope {
+	switch method {
+	case reviewmanagerv1.ReviewManager_AddTeam_FullMethodName,
+		reviewmanagerv1.ReviewManager_SetUserIsActive_FullMethodName,
+		reviewmanagerv1.ReviewManager_MoveUserTeam_FullMethodName:
+		return model.ScopeAdminTeam
+	case reviewmanagerv1.ReviewManager_CreatePullRequest_FullMethodName,
+		reviewmanagerv1.ReviewManager_MergePullRequest_FullMethodName,
+		reviewmanagerv1.ReviewManager_ReassignPullRequest_FullMethodName:
+		return model.ScopeWritePR
+	default:
+		return model.ScopeRead
+	}
+}
+
+// authenticate requires bearer token with scope of method and stores its owner in context
+// as actor of the call. Methods of other services, e.g. health checks, are public.
+// Like in HTTP API, failed authentications are charged to address of client in limiter and
+// calls from the address are rejected before token is looked up once its bucket is empty.
+func authenticate(
+	ctx context.Context, tokens authenticator, limiter *limit.Limiter, method string,
+) (context.Context, error) {
+	if public(method) {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, fmt.Errorf("bearer token is required: %w", model.ErrUnauthorized)
+	}
+	scheme, token, _ := strings.Cut(values[0], " ")
+	if !strings.EqualFold(scheme, "Bearer") || len(token) == 0 {
+		return nil, fmt.Errorf("bearer token is required: %w", model.ErrUnauthorized)
+	}
+	failed := limit.FailedAuthClient(peerHost(ctx))
+	if limiter.Check(failed, "") > 0 {
+		return nil, model.ErrRateLimited
+	}
+	actor, err := tokens.Authenticate(ctx, token)
+	if errors.Is(err, model.ErrUnauthorized) {
+		limiter.Reserve(failed, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scope := requiredScope(method)
+	if !slices.Contains(actor.Scopes, scope) {
+		return nil, fmt.Errorf("scope %s is required: %w", scope, model.ErrForbidden)
+	}
+
+	// Organization of actor scopes every query made while serving call.
+	return model.WithOrg(model.WithActor(ctx, actor), actor.Org), nil
+}
+
+// unaryAuth authenticates unary calls, see authenticate.
+func unaryAuth(tokens authenticator, limiter *limit.Limiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := authenticate(ctx, tokens, limiter, info.FullMethod)
+		if err != nil {
+			return nil, statusError(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamAuth authenticates streaming calls, see authenticate.
+func streamAuth(tokens authenticator, limiter *limit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), tokens, limiter, info.FullMethod)
+		if err != nil {
+			return statusError(err)
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream replaces context of stream.
+type serverStream struct {
+	grpc.ServerStream
+	//nolint:containedctx // context of stream is what is being replaced
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
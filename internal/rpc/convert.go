@@ -0,0 +1,157 @@
+package rpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// Statuses of pull requests in domain.
+const (
+	statusOpen   = "OPEN"
+	statusMerged = "MERGED"
+)
+
+func teamFromProto(t *reviewmanagerv1.Team) model.Team {
+	members := make([]model.User, 0, len(t.GetMembers()))
+	for _, m := range t.GetMembers() {
+		members = append(members, model.User{
+			UserID:   m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+			TeamName: "",
+		})
+	}
+	return model.Team{TeamName: t.GetTeamName(), Members: members, Archived: false}
+}
+
+func teamToProto(t model.Team) *reviewmanagerv1.Team {
+	members := make([]*reviewmanagerv1.User, 0, len(t.Members))
+	for _, m := range t.Members {
+		members = append(members, userToProto(m))
+	}
+	return &reviewmanagerv1.Team{TeamName: t.TeamName, Members: members, Archived: t.Archived}
+}
+
+func userToProto(u model.User) *reviewmanagerv1.User {
+	return &reviewmanagerv1.User{
+		UserId:   u.UserID,
+		Username: u.Username,
+		IsActive: u.IsActive,
+		TeamName: u.TeamName,
+	}
+}
+
+func pullRequestToProto(pr model.PullRequest) *reviewmanagerv1.PullRequest {
+	return &reviewmanagerv1.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            statusToProto(pr.Status),
+		AssignedReviewers: pr.Reviewers,
+		CreatedAt:         timestampToProto(pr.CreatedAt),
+		MergedAt:          timestampToProto(pr.MergedAt),
+	}
+}
+
+func shortToProto(pr model.PullRequestShort) *reviewmanagerv1.PullRequest {
+	return &reviewmanagerv1.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.Author,
+		Status:            statusToProto(pr.Status),
+		AssignedReviewers: pr.Reviewers,
+		CreatedAt:         timestampToProto(pr.CreatedAt),
+		MergedAt:          timestampToProto(pr.MergedAt),
+	}
+}
+
+func statusToProto(status string) reviewmanagerv1.PullRequestStatus {
+	switch status {
+	case statusOpen:
+		return reviewmanagerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case statusMerged:
+		return reviewmanagerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return reviewmanagerv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+// statusFromProto converts status filter, unspecified status matches any pull request.
+func statusFromProto(status reviewmanagerv1.PullRequestStatus) (string, error) {
+	switch status {
+	case reviewmanagerv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED:
+		return "", nil
+	case reviewmanagerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN:
+		return statusOpen, nil
+	case reviewmanagerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED:
+		return statusMerged, nil
+	default:
+		return "", invalidField("status", "unknown status %d", status)
+	}
+}
+
+func reviewChangeToProto(c model.ReviewChange) *reviewmanagerv1.ReviewChange {
+	action := reviewmanagerv1.ReviewAction_REVIEW_ACTION_UNSPECIFIED
+	switch c.Action {
+	case model.ReviewKept:
+		action = reviewmanagerv1.ReviewAction_REVIEW_ACTION_KEPT
+	case model.ReviewReassigned:
+		action = reviewmanagerv1.ReviewAction_REVIEW_ACTION_REASSIGNED
+	case model.ReviewRemoved:
+		action = reviewmanagerv1.ReviewAction_REVIEW_ACTION_REMOVED
+	}
+	return &reviewmanagerv1.ReviewChange{
+		PullRequestId: c.PRID,
+		OldUserId:     c.OldUserID,
+		NewUserId:     c.NewUserID,
+		Action:        action,
+	}
+}
+
+func eventToProto(e model.AssignmentEvent) *reviewmanagerv1.AssignmentEvent {
+	kind := reviewmanagerv1.AssignmentType_ASSIGNMENT_TYPE_UNSPECIFIED
+	switch e.Type {
+	case model.AssignmentAssigned:
+		kind = reviewmanagerv1.AssignmentType_ASSIGNMENT_TYPE_ASSIGNED
+	case model.AssignmentReassigned:
+		kind = reviewmanagerv1.AssignmentType_ASSIGNMENT_TYPE_REASSIGNED
+	}
+	return &reviewmanagerv1.AssignmentEvent{
+		Type:               kind,
+		PullRequestId:      e.PRID,
+		ReviewerId:         e.ReviewerID,
+		PreviousReviewerId: e.PreviousID,
+		Time:               timestamppb.New(e.Time),
+	}
+}
+
+func timestampToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// timestampFromProto converts optional timestamp, missing one is nil.
+func timestampFromProto(field string, t *timestamppb.Timestamp) (*time.Time, error) {
+	if t == nil {
+		return nil, nil //nolint:nilnil // missing timestamp is not used for filtering
+	}
+	err := t.CheckValid()
+	if err != nil {
+		return nil, invalidField(field, "%v", err)
+	}
+	v := t.AsTime()
+	return &v, nil
+}
+
+// invalidField reports single invalid field of request.
+func invalidField(field, format string, args ...any) error {
+	var v model.ValidationError
+	v.Add(field, format, args...)
+	return v.Err()
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// errorDomain is domain of ErrorInfo details.
+const errorDomain = "review-manager"
+
+// statusError converts error of use case to gRPC status. Its ErrorInfo reason is the same
+// code as in HTTP API, e.g. PR_MERGED, invalid fields are listed in BadRequest details.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	code, reason := errorCode(err)
+	st := status.New(code, err.Error())
+
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: nil}
+	detailed, detailsErr := st.WithDetails(info)
+	var validationErr *model.ValidationError
+	if detailsErr == nil && errors.As(err, &validationErr) {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErr.Fields))
+		for _, f := range validationErr.Fields {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:            f.Field,
+				Description:      f.Message,
+				Reason:           "",
+				LocalizedMessage: nil,
+			})
+		}
+		detailed, detailsErr = st.WithDetails(info, &errdetails.BadRequest{FieldViolations: violations})
+	}
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// errorCode maps errors of use cases to status code and code of HTTP API, see model.KindOf.
+// Only ends of streams and calls, which HTTP API doesn't report as errors, have their own codes.
+func errorCode(err error) (codes.Code, string) {
+	switch {
+	case errors.Is(err, events.ErrLagged):
+		return codes.ResourceExhausted, "LAGGED"
+	case errors.Is(err, events.ErrClosed):
+		return codes.Unavailable, "SHUTTING_DOWN"
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled, "CANCELED"
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded, "DEADLINE_EXCEEDED"
+	}
+	kind := model.KindOf(err)
+	return kind.GRPCCode, kind.Code
+}
@@ -0,0 +1,24 @@
+package rpc
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/limit"
+)
+
+var StatusError = statusError
+
+// NewWatchServer serves only WatchAssignments of broker, other methods need database.
+// Nil tokens disables authentication.
+func NewWatchServer(broker *events.Broker, tokens authenticator) *grpc.Server {
+	return newServer(&service{events: broker}, interceptors{tokens: tokens}) //nolint:exhaustruct
+}
+
+// NewLimitedWatchServer is NewWatchServer limited by limiter and slots, nil tokens disables authentication.
+func NewLimitedWatchServer(
+	broker *events.Broker, tokens authenticator, limiter *limit.Limiter, slots *limit.Slots,
+) *grpc.Server {
+	ic := interceptors{tokens: tokens, limiter: limiter, inFlight: slots} //nolint:exhaustruct
+	return newServer(&service{events: broker}, ic)
+}
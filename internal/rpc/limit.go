@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// operation returns HTTP operation mirrored by method, so route limits of HTTP API apply to gRPC calls too.
+// Other methods share default bucket of client.
+//
+//nolint:cyclop // flat mapping is easier to read than table
+func operation(method string) string {
+	switch method {
+	case reviewmanagerv1.ReviewManager_AddTeam_FullMethodName:
+		return "POST /team/add"
+	case reviewmanagerv1.ReviewManager_GetTeam_FullMethodName:
+		return "GET /team/get"
+	case reviewmanagerv1.ReviewManager_SetUserIsActive_FullMethodName:
+		return "POST /users/setIsActive"
+	case reviewmanagerv1.ReviewManager_MoveUserTeam_FullMethodName:
+		return "POST /users/moveTeam"
+	case reviewmanagerv1.ReviewManager_GetUserReviews_FullMethodName:
+		return "GET /users/getReview"
+	case reviewmanagerv1.ReviewManager_CreatePullRequest_FullMethodName:
+		return "POST /pullRequest/create"
+	case reviewmanagerv1.ReviewManager_GetPullRequest_FullMethodName:
+		return "GET /pullRequest/get"
+	case reviewmanagerv1.ReviewManager_ListPullRequests_FullMethodName:
+		return "GET /pullRequest/list"
+	case reviewmanagerv1.ReviewManager_MergePullRequest_FullMethodName:
+		return "POST /pullRequest/merge"
+	case reviewmanagerv1.ReviewManager_ReassignPullRequest_FullMethodName:
+		return "POST /pullRequest/reassign"
+	case reviewmanagerv1.ReviewManager_WatchAssignments_FullMethodName:
+		return "GET /events/stream"
+	default:
+		return ""
+	}
+}
+
+// clientKey identifies client of call for rate limiting, the same way as HTTP API does.
+func clientKey(ctx context.Context) string {
+	if actor, ok := model.ActorFrom(ctx); ok {
+		return "actor:" + actor.Org + ":" + actor.ID
+	}
+	return "ip:" + peerHost(ctx)
+}
+
+// peerHost returns address of client without port.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// public reports whether method belongs to other services, e.g. health checks, which are never limited.
+func public(method string) bool {
+	return !strings.HasPrefix(method, "/"+reviewmanagerv1.ReviewManager_ServiceDesc.ServiceName+"/")
+}
+
+// rateLimited rejects call of client that exceeded its limit with TOO_MANY_REQUESTS.
+// It must run inside authentication to tell clients apart by actor.
+func rateLimited(ctx context.Context, limiter *limit.Limiter, method string) error {
+	if public(method) || limiter.Reserve(clientKey(ctx), operation(method)) == 0 {
+		return nil
+	}
+	return statusError(model.ErrRateLimited)
+}
+
+// unaryRateLimit limits unary calls, see rateLimited.
+func unaryRateLimit(limiter *limit.Limiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		err := rateLimited(ctx, limiter, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamRateLimit limits streaming calls, see rateLimited.
+func streamRateLimit(limiter *limit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := rateLimited(ss.Context(), limiter, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// unaryInFlightLimit rejects unary calls with OVERLOADED while every slot is taken.
+// Streams stay open as long as client wants, so like event stream of HTTP API they take no slot.
+func unaryInFlightLimit(slots *limit.Slots) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		if public(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if !slots.Acquire() {
+			return nil, statusError(model.ErrOverloaded)
+		}
+		defer slots.Release()
+		return handler(ctx, req)
+	}
+}
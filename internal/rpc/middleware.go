@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// logCall writes access log entry of finished call.
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch {
+	case code == codes.Internal || code == codes.Unknown || code == codes.DataLoss:
+		level = slog.LevelError
+	case strings.HasPrefix(method, "/grpc."):
+		level = slog.LevelDebug
+	}
+	remoteAddr := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddr = p.Addr.String()
+	}
+	slog.Log(ctx, level, "Call served",
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("latency", time.Since(start)),
+		slog.String("remote_addr", remoteAddr),
+	)
+}
+
+// unaryAccessLog logs every unary call like access log of HTTP server.
+func unaryAccessLog(
+	ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+// streamAccessLog logs every streaming call when it is finished.
+func streamAccessLog(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logCall(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+// recovered turns panic in handler into INTERNAL status instead of dropped connection.
+func recovered(ctx context.Context, err *error) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	slog.ErrorContext(ctx, "Panic while serving call",
+		slog.Any("panic", v),
+		slog.String("stack", string(debug.Stack())),
+	)
+	*err = statusError(errors.New("internal error"))
+}
+
+// unaryRecovery recovers panics of unary calls.
+func unaryRecovery(
+	ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (resp any, err error) {
+	defer recovered(ctx, &err)
+	return handler(ctx, req)
+}
+
+// streamRecovery recovers panics of streaming calls.
+func streamRecovery(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer recovered(ss.Context(), &err)
+	return handler(srv, ss)
+}
@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// CreatePullRequest mirrors POST /pullRequest/create.
+func (s *service) CreatePullRequest(
+	ctx context.Context, req *reviewmanagerv1.CreatePullRequestRequest,
+) (*reviewmanagerv1.CreatePullRequestResponse, error) {
+	err := validate(req, validateCreatePullRequest)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	pr, err := s.create.Create(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &reviewmanagerv1.CreatePullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+// GetPullRequest mirrors GET /pullRequest/get.
+func (s *service) GetPullRequest(
+	ctx context.Context, req *reviewmanagerv1.GetPullRequestRequest,
+) (*reviewmanagerv1.GetPullRequestResponse, error) {
+	pr, err := s.get.Get(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &reviewmanagerv1.GetPullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+// ListPullRequests mirrors GET /pullRequest/list.
+func (s *service) ListPullRequests(
+	ctx context.Context, req *reviewmanagerv1.ListPullRequestsRequest,
+) (*reviewmanagerv1.ListPullRequestsResponse, error) {
+	filter, err := listFilter(req)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	page, err := s.list.List(ctx, filter, req.GetCursor())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	resp := &reviewmanagerv1.ListPullRequestsResponse{
+		PullRequests: make([]*reviewmanagerv1.PullRequest, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+		Total:        int32(page.Total), //nolint:gosec // total is far below int32 limit
+	}
+	for _, pr := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, pullRequestToProto(pr))
+	}
+	return resp, nil
+}
+
+// listFilter converts ListPullRequests request to domain filter.
+func listFilter(req *reviewmanagerv1.ListPullRequestsRequest) (model.PullRequestFilter, error) {
+	status, err := statusFromProto(req.GetStatus())
+	if err != nil {
+		return model.PullRequestFilter{}, err
+	}
+	filter := model.PullRequestFilter{
+		Status:      status,
+		AuthorID:    req.GetAuthorId(),
+		ReviewerID:  req.GetReviewerId(),
+		TeamName:    req.GetTeamName(),
+		Name:        req.GetName(),
+		CreatedFrom: nil,
+		CreatedTo:   nil,
+		MergedFrom:  nil,
+		MergedTo:    nil,
+		After:       nil,
+		Descending:  false,
+		Limit:       int(req.GetLimit()),
+	}
+	for _, ts := range []struct {
+		field string
+		value **time.Time
+		proto *timestamppb.Timestamp
+	}{
+		{"created_from", &filter.CreatedFrom, req.GetCreatedFrom()},
+		{"created_to", &filter.CreatedTo, req.GetCreatedTo()},
+		{"merged_from", &filter.MergedFrom, req.GetMergedFrom()},
+		{"merged_to", &filter.MergedTo, req.GetMergedTo()},
+	} {
+		*ts.value, err = timestampFromProto(ts.field, ts.proto)
+		if err != nil {
+			return model.PullRequestFilter{}, err
+		}
+	}
+	return filter, nil
+}
+
+// MergePullRequest mirrors POST /pullRequest/merge.
+func (s *service) MergePullRequest(
+	ctx context.Context, req *reviewmanagerv1.MergePullRequestRequest,
+) (*reviewmanagerv1.MergePullRequestResponse, error) {
+	err := validate(req, validateMergePullRequest)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	pr, err := s.merge.Merge(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &reviewmanagerv1.MergePullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+// ReassignPullRequest mirrors POST /pullRequest/reassign.
+func (s *service) ReassignPullRequest(
+	ctx context.Context, req *reviewmanagerv1.ReassignPullRequestRequest,
+) (*reviewmanagerv1.ReassignPullRequestResponse, error) {
+	err := validate(req, validateReassignPullRequest)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	res, err := s.reassign.Reassign(ctx, model.Reviewer{PRID: req.GetPullRequestId(), UID: req.GetOldUserId()})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &reviewmanagerv1.ReassignPullRequestResponse{
+		Pr:         pullRequestToProto(res.PR),
+		ReplacedBy: res.ReplacedBy,
+	}, nil
+}
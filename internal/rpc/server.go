@@ -0,0 +1,124 @@
+// Package rpc serves gRPC API on top of the same use cases as HTTP API.
+package rpc
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/app"
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/metrics"
+	"github.com/LeonovDS/review-manager/internal/oidc"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+	"github.com/LeonovDS/review-manager/internal/usecase/user"
+)
+
+// Options configures gRPC server.
+type Options struct {
+	// Reviewers is number of reviewers assigned to new pull request.
+	Reviewers int
+	// Metrics receives assignment outcomes, may be nil.
+	Metrics *metrics.Metrics
+	// Events delivers assignments committed by any replica to WatchAssignments.
+	// Nil disables WatchAssignments.
+	Events *events.Broker
+	// Auth requires bearer token with scopes of HTTP API.
+	Auth bool
+	// JWT verifies bearer tokens of engineers, may be nil. Without it only API keys are accepted.
+	JWT *oidc.Verifier
+	// RateLimiter limits calls of every client, may be nil. It is shared with HTTP router.
+	RateLimiter *limit.Limiter
+	// InFlight limits number of calls served at once, may be nil. It is shared with HTTP router.
+	InFlight *limit.Slots
+}
+
+// service implements reviewmanagerv1.ReviewManagerServer.
+type service struct {
+	reviewmanagerv1.UnimplementedReviewManagerServer
+
+	teamAdd *team.Adder
+	teamGet *team.Getter
+
+	reviews  *user.ReviewGetter
+	isActive *user.StatusUpdater
+	move     *user.TeamMover
+
+	create   *pullrequest.Creator
+	get      *pullrequest.Getter
+	list     *pullrequest.Lister
+	merge    *pullrequest.Merger
+	reassign *pullrequest.Reassigner
+
+	events *events.Broker
+}
+
+// NewServer builds service on top of the same use cases as HTTP router.
+func NewServer(pool *pgxpool.Pool, opts Options) *grpc.Server {
+	uc := app.New(pool, app.Options{Reviewers: opts.Reviewers, Metrics: opts.Metrics, JWT: opts.JWT})
+	svc := &service{
+		UnimplementedReviewManagerServer: reviewmanagerv1.UnimplementedReviewManagerServer{},
+
+		teamAdd: uc.TeamAdd,
+		teamGet: uc.TeamGet,
+
+		reviews:  uc.Reviews,
+		isActive: uc.IsActive,
+		move:     uc.Move,
+
+		create:   uc.Create,
+		get:      uc.Get,
+		list:     uc.List,
+		merge:    uc.Merge,
+		reassign: uc.Reassign,
+
+		events: opts.Events,
+	}
+
+	ic := interceptors{tokens: nil, limiter: opts.RateLimiter, inFlight: opts.InFlight, metrics: opts.Metrics}
+	if opts.Auth {
+		ic.tokens = uc.Tokens
+	}
+	return newServer(svc, ic)
+}
+
+// interceptors configures what is done around every call. Zero value only logs calls and recovers panics.
+type interceptors struct {
+	// tokens authenticates calls, nil disables authentication.
+	tokens   authenticator
+	limiter  *limit.Limiter
+	inFlight *limit.Slots
+	metrics  *metrics.Metrics
+}
+
+// newServer registers service with health and reflection services.
+func newServer(svc *service, ic interceptors) *grpc.Server {
+	// First interceptor is outermost: every call is counted and logged, panics of auth are recovered too.
+	// Like in HTTP router, in-flight limit comes before authentication and rate limit after it.
+	unary := []grpc.UnaryServerInterceptor{
+		ic.metrics.UnaryInterceptor(), unaryAccessLog, unaryInFlightLimit(ic.inFlight), unaryRecovery,
+	}
+	stream := []grpc.StreamServerInterceptor{ic.metrics.StreamInterceptor(), streamAccessLog, streamRecovery}
+	if ic.tokens != nil {
+		unary = append(unary, unaryAuth(ic.tokens, ic.limiter))
+		stream = append(stream, streamAuth(ic.tokens, ic.limiter))
+	}
+	unary = append(unary, unaryRateLimit(ic.limiter))
+	stream = append(stream, streamRateLimit(ic.limiter))
+
+	server := grpc.NewServer(
+		grpc.StatsHandler(telemetry.ServerHandler()),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+	reviewmanagerv1.RegisterReviewManagerServer(server, svc)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	reflection.Register(server)
+	return server
+}
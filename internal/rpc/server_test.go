@@ -0,0 +1,357 @@
+package rpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/events"
+	"github.com/LeonovDS/review-manager/internal/limit"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/rpc"
+)
+
+// fakeTokens authenticates actors by token.
+type fakeTokens map[string]model.Actor
+
+func (f fakeTokens) Authenticate(_ context.Context, token string) (model.Actor, error) {
+	actor, ok := f[token]
+	if !ok {
+		return model.Actor{}, fmt.Errorf("unknown token: %w", model.ErrUnauthorized)
+	}
+	return actor, nil
+}
+
+//nolint:exhaustruct
+func testTokens() fakeTokens {
+	return fakeTokens{
+		"reader": {ID: "k1", Org: "acme", Scopes: []model.Scope{model.ScopeRead}},
+		"writer": {ID: "k2", Org: "acme", Scopes: []model.Scope{model.ScopeWritePR}},
+		"other":  {ID: "k3", Org: "other", Scopes: []model.Scope{model.ScopeRead}},
+	}
+}
+
+func dial(t *testing.T, server *grpc.Server) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func withToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// watch starts watching and waits until events are delivered to stream.
+func watch(
+	t *testing.T, client reviewmanagerv1.ReviewManagerClient, ctx context.Context,
+	req *reviewmanagerv1.WatchAssignmentsRequest,
+) grpc.ServerStreamingClient[reviewmanagerv1.AssignmentEvent] {
+	t.Helper()
+	stream, err := client.WatchAssignments(ctx, req)
+	require.NoError(t, err)
+	_, err = stream.Header()
+	require.NoError(t, err)
+	return stream
+}
+
+func reason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+//nolint:exhaustruct
+func TestWatchAssignments(t *testing.T) {
+	broker := events.NewBroker()
+	client := reviewmanagerv1.NewReviewManagerClient(dial(t, rpc.NewWatchServer(broker, testTokens())))
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	all := watch(t, client, withToken(ctx, "reader"), &reviewmanagerv1.WatchAssignmentsRequest{})
+	byReviewer := watch(t, client, withToken(ctx, "reader"), &reviewmanagerv1.WatchAssignmentsRequest{ReviewerId: "u3"})
+	other := watch(t, client, withToken(ctx, "other"), &reviewmanagerv1.WatchAssignmentsRequest{})
+
+	at := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	broker.Publish(
+		model.AssignmentEvent{Type: model.AssignmentAssigned, Org: "acme", PRID: "pr-1", ReviewerID: "u2", Time: at},
+		model.AssignmentEvent{Type: model.AssignmentAssigned, Org: "other", PRID: "pr-9", ReviewerID: "u2", Time: at},
+		model.AssignmentEvent{
+			Type: model.AssignmentReassigned, Org: "acme", PRID: "pr-1", ReviewerID: "u3", PreviousID: "u2", Time: at,
+		},
+	)
+
+	e, err := all.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, reviewmanagerv1.AssignmentType_ASSIGNMENT_TYPE_ASSIGNED, e.GetType())
+	assert.Equal(t, "pr-1", e.GetPullRequestId())
+	assert.Equal(t, "u2", e.GetReviewerId())
+	assert.Equal(t, at, e.GetTime().AsTime())
+	e, err = all.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, reviewmanagerv1.AssignmentType_ASSIGNMENT_TYPE_REASSIGNED, e.GetType())
+	assert.Equal(t, "u2", e.GetPreviousReviewerId())
+
+	// Filters and organization of caller are applied.
+	e, err = byReviewer.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "u3", e.GetReviewerId())
+	e, err = other.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "pr-9", e.GetPullRequestId())
+
+	// Streams are ended on shutdown.
+	broker.Close()
+	_, err = all.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, "SHUTTING_DOWN", reason(err))
+}
+
+func TestAuth(t *testing.T) {
+	conn := dial(t, rpc.NewWatchServer(events.NewBroker(), testTokens()))
+	client := reviewmanagerv1.NewReviewManagerClient(conn)
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{name: "no token", ctx: ctx, code: codes.Unauthenticated},
+		{name: "unknown token", ctx: withToken(ctx, "unknown"), code: codes.Unauthenticated},
+		{name: "missing scope", ctx: withToken(ctx, "writer"), code: codes.PermissionDenied},
+		// Authorized call reaches service without use cases, its panic is recovered as INTERNAL.
+		{name: "authorized", ctx: withToken(ctx, "reader"), code: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetPullRequest(tt.ctx, &reviewmanagerv1.GetPullRequestRequest{PullRequestId: "pr-1"})
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+
+	// Health checks are public.
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: ""})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+}
+
+func TestStatusError(t *testing.T) {
+	var invalid model.ValidationError
+	invalid.Add("members.0.user_id", "is required")
+
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{err: invalid.Err(), code: codes.InvalidArgument, reason: "BAD_REQUEST"},
+		{err: fmt.Errorf("get team: %w", model.ErrNotFound), code: codes.NotFound, reason: "NOT_FOUND"},
+		{err: model.ErrPRExists, code: codes.AlreadyExists, reason: "PR_EXISTS"},
+		{err: model.ErrPRMerged, code: codes.FailedPrecondition, reason: "PR_MERGED"},
+		{err: model.ErrNoCandidate, code: codes.FailedPrecondition, reason: "NO_CANDIDATE"},
+		{err: model.ErrForbidden, code: codes.PermissionDenied, reason: "FORBIDDEN"},
+		{err: model.ErrRateLimited, code: codes.ResourceExhausted, reason: "TOO_MANY_REQUESTS"},
+		{err: events.ErrLagged, code: codes.ResourceExhausted, reason: "LAGGED"},
+		{err: assert.AnError, code: codes.Internal, reason: "INTERNAL_ERROR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			err := rpc.StatusError(tt.err)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.reason, reason(err))
+		})
+	}
+
+	// Invalid fields are listed like in HTTP API.
+	details := status.Convert(rpc.StatusError(invalid.Err())).Details()
+	require.Len(t, details, 2)
+	badRequest, ok := details[1].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "members.0.user_id", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "is required", badRequest.GetFieldViolations()[0].GetDescription())
+}
+
+// fieldViolations lists invalid fields reported by error.
+func fieldViolations(err error) []string {
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if badRequest, ok := d.(*errdetails.BadRequest); ok {
+			for _, f := range badRequest.GetFieldViolations() {
+				fields = append(fields, f.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+//nolint:exhaustruct
+func TestValidation(t *testing.T) {
+	// Invalid requests are rejected before use cases, so server without database is enough.
+	client := reviewmanagerv1.NewReviewManagerClient(dial(t, rpc.NewWatchServer(events.NewBroker(), nil)))
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		name   string
+		call   func() error
+		fields []string
+	}{
+		{
+			name: "add team",
+			call: func() error {
+				_, err := client.AddTeam(ctx, &reviewmanagerv1.AddTeamRequest{Team: &reviewmanagerv1.Team{
+					TeamName: " backend",
+					Members: []*reviewmanagerv1.User{
+						{UserId: "u1", Username: "Alice"},
+						{UserId: "bad id", Username: "Bob"},
+						{UserId: "u1", Username: ""},
+					},
+				}})
+				return err
+			},
+			fields: []string{"team_name", "members.1.user_id", "members.2.username", "members.2.user_id"},
+		},
+		{
+			name: "create pull request",
+			call: func() error {
+				_, err := client.CreatePullRequest(ctx, &reviewmanagerv1.CreatePullRequestRequest{
+					PullRequestId: "pr/1", PullRequestName: "", AuthorId: "u1",
+				})
+				return err
+			},
+			fields: []string{"pull_request_id", "pull_request_name"},
+		},
+		{
+			name: "reassign pull request",
+			call: func() error {
+				_, err := client.ReassignPullRequest(ctx, &reviewmanagerv1.ReassignPullRequestRequest{
+					PullRequestId: "pr-1",
+				})
+				return err
+			},
+			fields: []string{"old_user_id"},
+		},
+		{
+			name: "move user",
+			call: func() error {
+				_, err := client.MoveUserTeam(ctx, &reviewmanagerv1.MoveUserTeamRequest{UserId: "u1", TeamName: ""})
+				return err
+			},
+			fields: []string{"team_name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Equal(t, tt.fields, fieldViolations(err))
+		})
+	}
+}
+
+//nolint:exhaustruct
+func TestRateLimit(t *testing.T) {
+	now := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	limiter := limit.NewLimiter(limit.Rates{
+		Default: limit.Rate{Rate: 0.001, Burst: 1},
+		// Calls share route limits with HTTP operations they mirror.
+		Routes: map[string]limit.Rate{"POST /pullRequest/create": {Rate: 0.001, Burst: 1}},
+	}, func() time.Time { return now })
+	conn := dial(t, rpc.NewLimitedWatchServer(events.NewBroker(), nil, limiter, nil))
+	client := reviewmanagerv1.NewReviewManagerClient(conn)
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	create := func() error {
+		_, err := client.CreatePullRequest(ctx, &reviewmanagerv1.CreatePullRequestRequest{PullRequestId: "pr/1"})
+		return err
+	}
+	assert.Equal(t, codes.InvalidArgument, status.Code(create()))
+	err := create()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, "TOO_MANY_REQUESTS", reason(err))
+
+	// Other methods use default bucket of client.
+	_, err = client.MergePullRequest(ctx, &reviewmanagerv1.MergePullRequestRequest{PullRequestId: ""})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.MergePullRequest(ctx, &reviewmanagerv1.MergePullRequestRequest{PullRequestId: ""})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Health checks are never limited.
+	for range 3 {
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: ""})
+		require.NoError(t, err)
+	}
+}
+
+//nolint:exhaustruct
+func TestAuthLimitsFailures(t *testing.T) {
+	now := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	limiter := limit.NewLimiter(limit.Rates{Default: limit.Rate{Rate: 0.001, Burst: 1}},
+		func() time.Time { return now })
+	conn := dial(t, rpc.NewLimitedWatchServer(events.NewBroker(), testTokens(), limiter, nil))
+	client := reviewmanagerv1.NewReviewManagerClient(conn)
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	getTeam := func(token string) error {
+		_, err := client.GetTeam(withToken(ctx, token), &reviewmanagerv1.GetTeamRequest{TeamName: ""})
+		return err
+	}
+	assert.Equal(t, codes.Unauthenticated, status.Code(getTeam("rm_guess1")))
+	// Address ran out of failed attempts, so even valid token isn't looked up until bucket refills.
+	err := getTeam("reader")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, "TOO_MANY_REQUESTS", reason(err))
+
+	now = now.Add(time.Hour)
+	assert.Equal(t, codes.InvalidArgument, status.Code(getTeam("reader")))
+}
+
+//nolint:exhaustruct
+func TestInFlightLimit(t *testing.T) {
+	slots := limit.NewSlots(1)
+	conn := dial(t, rpc.NewLimitedWatchServer(events.NewBroker(), nil, nil, slots))
+	client := reviewmanagerv1.NewReviewManagerClient(conn)
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	// Slot is taken by request of HTTP API.
+	require.True(t, slots.Acquire())
+	_, err := client.MergePullRequest(ctx, &reviewmanagerv1.MergePullRequestRequest{PullRequestId: ""})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, "OVERLOADED", reason(err))
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: ""})
+	require.NoError(t, err)
+
+	slots.Release()
+	_, err = client.MergePullRequest(ctx, &reviewmanagerv1.MergePullRequestRequest{PullRequestId: ""})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"context"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+)
+
+// AddTeam mirrors POST /team/add.
+func (s *service) AddTeam(
+	ctx context.Context, req *reviewmanagerv1.AddTeamRequest,
+) (*reviewmanagerv1.AddTeamResponse, error) {
+	err := validate(req, validateAddTeam)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	team, err := s.teamAdd.Add(ctx, teamFromProto(req.GetTeam()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &reviewmanagerv1.AddTeamResponse{Team: teamToProto(team)}, nil
+}
+
+// GetTeam mirrors GET /team/get.
+func (s *service) GetTeam(
+	ctx context.Context, req *reviewmanagerv1.GetTeamRequest,
+) (*reviewmanagerv1.GetTeamResponse, error) {
+	team, err := s.teamGet.Get(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &reviewmanagerv1.GetTeamResponse{Team: teamToProto(team)}, nil
+}
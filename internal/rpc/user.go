@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"context"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// SetUserIsActive mirrors POST /users/setIsActive.
+func (s *service) SetUserIsActive(
+	ctx context.Context, req *reviewmanagerv1.SetUserIsActiveRequest,
+) (*reviewmanagerv1.SetUserIsActiveResponse, error) {
+	err := validate(req, validateSetUserIsActive)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	user, err := s.isActive.SetIsActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &reviewmanagerv1.SetUserIsActiveResponse{User: userToProto(user)}, nil
+}
+
+// MoveUserTeam mirrors POST /users/moveTeam.
+func (s *service) MoveUserTeam(
+	ctx context.Context, req *reviewmanagerv1.MoveUserTeamRequest,
+) (*reviewmanagerv1.MoveUserTeamResponse, error) {
+	err := validate(req, validateMoveUserTeam)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	change, err := s.move.MoveTeam(ctx, req.GetUserId(), req.GetTeamName(), req.GetReassignReviews())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	resp := &reviewmanagerv1.MoveUserTeamResponse{
+		TeamName:             change.TeamName,
+		Users:                make([]*reviewmanagerv1.User, 0, len(change.Users)),
+		AffectedPullRequests: make([]*reviewmanagerv1.ReviewChange, 0, len(change.Affected)),
+	}
+	for _, u := range change.Users {
+		resp.Users = append(resp.Users, userToProto(u))
+	}
+	for _, c := range change.Affected {
+		resp.AffectedPullRequests = append(resp.AffectedPullRequests, reviewChangeToProto(c))
+	}
+	return resp, nil
+}
+
+// GetUserReviews mirrors GET /users/getReview.
+func (s *service) GetUserReviews(
+	ctx context.Context, req *reviewmanagerv1.GetUserReviewsRequest,
+) (*reviewmanagerv1.GetUserReviewsResponse, error) {
+	status, err := statusFromProto(req.GetStatus())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	report, err := s.reviews.Get(ctx, req.GetUserId(), model.ReviewQuery{
+		Status:     status,
+		Descending: req.GetDescending(),
+		Limit:      int(req.GetLimit()),
+		Cursor:     req.GetCursor(),
+		Details:    req.GetDetails(),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	resp := &reviewmanagerv1.GetUserReviewsResponse{
+		UserId:       report.UserID,
+		PullRequests: make([]*reviewmanagerv1.PullRequest, 0, len(report.PullRequests)),
+		NextCursor:   report.NextCursor,
+	}
+	for _, pr := range report.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, shortToProto(pr))
+	}
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// validate checks request with the same rules as HTTP API, so both transports reject the same input.
+func validate[T any](req T, check func(v *model.ValidationError, req T)) error {
+	var v model.ValidationError
+	check(&v, req)
+	return v.Err()
+}
+
+func validateAddTeam(v *model.ValidationError, req *reviewmanagerv1.AddTeamRequest) {
+	v.CheckTeam(teamFromProto(req.GetTeam()))
+}
+
+func validateSetUserIsActive(v *model.ValidationError, req *reviewmanagerv1.SetUserIsActiveRequest) {
+	v.CheckID("user_id", req.GetUserId())
+}
+
+func validateMoveUserTeam(v *model.ValidationError, req *reviewmanagerv1.MoveUserTeamRequest) {
+	v.CheckMove(req.GetUserId(), req.GetTeamName())
+}
+
+func validateCreatePullRequest(v *model.ValidationError, req *reviewmanagerv1.CreatePullRequestRequest) {
+	v.CheckPullRequest(req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId())
+}
+
+func validateMergePullRequest(v *model.ValidationError, req *reviewmanagerv1.MergePullRequestRequest) {
+	v.CheckID("pull_request_id", req.GetPullRequestId())
+}
+
+func validateReassignPullRequest(v *model.ValidationError, req *reviewmanagerv1.ReassignPullRequestRequest) {
+	v.CheckReviewer(model.Reviewer{PRID: req.GetPullRequestId(), UID: req.GetOldUserId()})
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	reviewmanagerv1 "github.com/LeonovDS/review-manager/api/reviewmanager/v1"
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// WatchAssignments streams assignments of organization of caller until call is canceled.
+// Assignments made before the call are not sent.
+func (s *service) WatchAssignments(
+	req *reviewmanagerv1.WatchAssignmentsRequest, stream grpc.ServerStreamingServer[reviewmanagerv1.AssignmentEvent],
+) error {
+	if s.events == nil {
+		return s.UnimplementedReviewManagerServer.WatchAssignments(req, stream)
+	}
+	ctx := stream.Context()
+	sub := s.events.Subscribe(model.OrgFrom(ctx))
+	defer sub.Close()
+	// Headers tell client that events published from now on will be delivered.
+	err := stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return statusError(ctx.Err())
+		case e, ok := <-sub.Events():
+			if !ok {
+				return statusError(sub.Err())
+			}
+			if !matches(req, e) {
+				continue
+			}
+			err = stream.Send(eventToProto(e))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// matches reports whether event passes filters of request.
+func matches(req *reviewmanagerv1.WatchAssignmentsRequest, e model.AssignmentEvent) bool {
+	return e.Type != model.AssignmentMerged &&
+		(len(req.GetReviewerId()) == 0 || req.GetReviewerId() == e.ReviewerID) &&
+		(len(req.GetPullRequestId()) == 0 || req.GetPullRequestId() == e.PRID)
+}
@@ -0,0 +1,12 @@
+package telemetry
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc/stats"
+)
+
+// ServerHandler creates server span for each gRPC call and extracts incoming trace context.
+// Span is named after full method, e.g. reviewmanager.v1.ReviewManager/CreatePullRequest.
+func ServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler()
+}
@@ -0,0 +1,37 @@
+package telemetry
+
+import (
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware creates server span for each request and extracts incoming trace context.
+// It must wrap http.ServeMux: route is known only after mux matched the request,
+// so span is renamed once request is served.
+func Middleware(next http.Handler) http.Handler {
+	withRoute := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Mux records matched pattern in request it gets. Copy keeps it from otelhttp,
+		// which would rename span back to operation name after request is served.
+		r = r.WithContext(r.Context())
+		next.ServeHTTP(w, r)
+		if len(r.Pattern) != 0 {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + route(r.Pattern))
+			span.SetAttributes(semconv.HTTPRoute(route(r.Pattern)))
+		}
+	})
+	return otelhttp.NewHandler(withRoute, "http.request")
+}
+
+// route strips method from ServeMux pattern.
+func route(pattern string) string {
+	_, path, found := strings.Cut(pattern, " ")
+	if !found {
+		return pattern
+	}
+	return path
+}
@@ -0,0 +1,63 @@
+// Package telemetry sets up OpenTelemetry tracing and helps use cases to create spans.
+package telemetry
+
+import (
+	"context"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is instrumentation scope of spans created by the service.
+const ScopeName = "github.com/LeonovDS/review-manager"
+
+// Attribute keys used in spans.
+const (
+	PullRequestKey = attribute.Key("pull_request.id")
+	UserKey        = attribute.Key("user.id")
+	TeamKey        = attribute.Key("team.name")
+	APIKeyKey      = attribute.Key("api_key.id")
+	ActorKey       = attribute.Key("actor.id")
+	OrgKey         = attribute.Key("org.id")
+)
+
+// Start creates span using global tracer provider. Caller must end span.
+// Span records actor of request and its organization, if ctx has one.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if actor, ok := model.ActorFrom(ctx); ok {
+		attrs = append(attrs, ActorKey.String(actor.ID), OrgKey.String(actor.Org))
+	}
+	return otel.Tracer(ScopeName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Error records err in span and returns it unchanged, so it can be used in return statement.
+func Error(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// PullRequest is attribute with pull request id.
+func PullRequest(id string) attribute.KeyValue {
+	return PullRequestKey.String(id)
+}
+
+// User is attribute with user id.
+func User(id string) attribute.KeyValue {
+	return UserKey.String(id)
+}
+
+// Team is attribute with team name.
+func Team(name string) attribute.KeyValue {
+	return TeamKey.String(name)
+}
+
+// APIKey is attribute with API key id.
+func APIKey(id string) attribute.KeyValue {
+	return APIKeyKey.String(id)
+}
@@ -0,0 +1,97 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestMiddlewareNamesSpanAfterRoute(t *testing.T) {
+	recorder := setupRecorder(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /team/get", func(w http.ResponseWriter, r *http.Request) {
+		_, span := telemetry.Start(r.Context(), "team.Get", telemetry.Team("backend"))
+		span.End()
+		w.WriteHeader(http.StatusOK)
+	})
+
+	telemetry.Middleware(mux).ServeHTTP(httptest.NewRecorder(),
+		httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "team.Get", spans[0].Name())
+	assert.Equal(t, "GET /team/get", spans[1].Name())
+	assert.Contains(t, spans[1].Attributes(), semconv.HTTPRoute("/team/get"))
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+}
+
+func TestMiddlewareKeepsNameOfUnmatchedRequest(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	telemetry.Middleware(http.NewServeMux()).ServeHTTP(httptest.NewRecorder(),
+		httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "http.request", spans[0].Name())
+}
+
+func TestErrorMarksSpan(t *testing.T) {
+	recorder := setupRecorder(t)
+	_, span := telemetry.Start(t.Context(), "pullrequest.Reassign")
+	err := telemetry.Error(span, errors.New("no candidate"))
+	span.End()
+
+	assert.EqualError(t, err, "no candidate")
+	assert.Equal(t, codes.Error, recorder.Ended()[0].Status().Code)
+}
+
+func TestStartRecordsActor(t *testing.T) {
+	recorder := setupRecorder(t)
+	ctx := model.WithActor(t.Context(), model.Actor{ID: "k1", Name: "ci", Org: "payments"})
+	_, span := telemetry.Start(ctx, "pullrequest.Create", telemetry.PullRequest("pr-1"))
+	span.End()
+
+	attrs := recorder.Ended()[0].Attributes()
+	assert.Contains(t, attrs, telemetry.PullRequest("pr-1"))
+	assert.Contains(t, attrs, telemetry.ActorKey.String("k1"))
+	assert.Contains(t, attrs, telemetry.OrgKey.String("payments"))
+}
+
+func TestLogHandlerAddsTraceID(t *testing.T) {
+	setupRecorder(t)
+	var buf bytes.Buffer
+	logger := slog.New(telemetry.NewLogHandler(slog.NewTextHandler(&buf, nil)))
+
+	ctx, span := telemetry.Start(t.Context(), "test")
+	logger.InfoContext(ctx, "inside span")
+	span.End()
+	logger.Info("outside span")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 2)
+	assert.Contains(t, string(lines[0]), "trace_id="+span.SpanContext().TraceID().String())
+	assert.NotContains(t, string(lines[1]), "trace_id")
+}
@@ -0,0 +1,121 @@
+package apikey_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/apikey"
+)
+
+// memoryKeys keeps keys by hex of token hash.
+type memoryKeys map[string]model.APIKey
+
+func (m memoryKeys) Add(_ context.Context, key model.APIKey, hash []byte) (model.APIKey, error) {
+	key.CreatedAt = time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	m[hex.EncodeToString(hash)] = key
+	return key, nil
+}
+
+func (m memoryKeys) GetByHash(_ context.Context, hash []byte) (model.APIKey, error) {
+	key, ok := m[hex.EncodeToString(hash)]
+	if !ok {
+		return model.APIKey{}, model.ErrNotFound
+	}
+	return key, nil
+}
+
+func TestCreate_Authenticate(t *testing.T) {
+	keys := memoryKeys{}
+	creator := apikey.Creator{Key: keys}
+	auth := apikey.Authenticator{Key: keys}
+
+	issued, err := creator.Create(t.Context(), "ci", []model.Scope{model.ScopeWritePR, model.ScopeRead, model.ScopeRead})
+	require.NoError(t, err)
+	assert.Equal(t, []model.Scope{model.ScopeRead, model.ScopeWritePR}, issued.Key.Scopes)
+	assert.Contains(t, issued.Token, apikey.TokenPrefix+issued.Key.ID+"_")
+	assert.NotContains(t, keys, issued.Token, "token must be stored hashed")
+
+	actor, err := auth.Authenticate(t.Context(), issued.Token)
+	require.NoError(t, err)
+	assert.Equal(t, issued.Key.ID, actor.ID)
+	assert.Equal(t, issued.Key.Scopes, actor.Scopes)
+	assert.True(t, actor.CanManageAll(), "API keys are limited only by scopes")
+
+	_, err = auth.Authenticate(t.Context(), issued.Token+"x")
+	require.ErrorIs(t, err, model.ErrUnauthorized)
+	_, err = auth.Authenticate(t.Context(), "not-a-token")
+	require.ErrorIs(t, err, model.ErrUnauthorized)
+}
+
+func TestAuthenticate_Revoked(t *testing.T) {
+	keys := memoryKeys{}
+	issued, err := (&apikey.Creator{Key: keys}).Create(t.Context(), "ci", []model.Scope{model.ScopeRead})
+	require.NoError(t, err)
+	revokedAt := time.Now()
+	for hash, key := range keys {
+		key.RevokedAt = &revokedAt
+		keys[hash] = key
+	}
+
+	_, err = (&apikey.Authenticator{Key: keys}).Authenticate(t.Context(), issued.Token)
+
+	require.ErrorIs(t, err, model.ErrUnauthorized)
+}
+
+func TestCreate_Org(t *testing.T) {
+	keys := memoryKeys{}
+	ctx := model.WithOrg(t.Context(), "payments")
+
+	issued, err := (&apikey.Creator{Key: keys}).Create(ctx, "ci", []model.Scope{model.ScopeRead})
+	require.NoError(t, err)
+	assert.Equal(t, "payments", issued.Key.Org)
+
+	// Token is looked up without organization, key brings its own.
+	actor, err := (&apikey.Authenticator{Key: keys}).Authenticate(t.Context(), issued.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "payments", actor.Org)
+}
+
+func TestCreate_Validate(t *testing.T) {
+	creator := apikey.Creator{Key: memoryKeys{}}
+
+	_, err := creator.Create(t.Context(), "", []model.Scope{model.ScopeRead})
+	require.ErrorIs(t, err, model.ErrBadRequest)
+	_, err = creator.Create(t.Context(), "ci", nil)
+	require.ErrorIs(t, err, model.ErrBadRequest)
+	_, err = creator.Create(t.Context(), "ci", []model.Scope{"write:team"})
+	require.ErrorIs(t, err, model.ErrBadRequest)
+}
+
+// fakeUsers authenticates any token as engineer.
+type fakeUsers struct{}
+
+func (fakeUsers) Authenticate(_ context.Context, _ string) (model.Actor, error) {
+	return model.Actor{ID: "u1", Name: "", Org: "", UserID: "u1", Role: "", Team: "", Scopes: nil}, nil
+}
+
+func TestBearer(t *testing.T) {
+	keys := memoryKeys{}
+	issued, err := (&apikey.Creator{Key: keys}).Create(t.Context(), "ci", []model.Scope{model.ScopeRead})
+	require.NoError(t, err)
+
+	// API keys are told by prefix, other tokens go to JWT authenticator.
+	bearer := apikey.Bearer{Keys: &apikey.Authenticator{Key: keys}, Users: fakeUsers{}}
+	actor, err := bearer.Authenticate(t.Context(), issued.Token)
+	require.NoError(t, err)
+	assert.Equal(t, issued.Key.ID, actor.ID)
+	actor, err = bearer.Authenticate(t.Context(), "eyJhbGciOiJSUzI1NiJ9.e30.sig")
+	require.NoError(t, err)
+	assert.Equal(t, "u1", actor.UserID)
+
+	// Without JWT every token must be API key.
+	bearer.Users = nil
+	_, err = bearer.Authenticate(t.Context(), "eyJhbGciOiJSUzI1NiJ9.e30.sig")
+	assert.ErrorIs(t, err, model.ErrUnauthorized)
+}
@@ -0,0 +1,61 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// Authenticator provides use case for finding API key of request by its token.
+type Authenticator struct {
+	Key keyGetterRepo
+}
+
+type keyGetterRepo interface {
+	GetByHash(ctx context.Context, hash []byte) (model.APIKey, error)
+}
+
+// Authenticate returns actor of active key with given token.
+// Unknown and revoked keys are reported as model.ErrUnauthorized.
+func (u *Authenticator) Authenticate(ctx context.Context, token string) (model.Actor, error) {
+	if !strings.HasPrefix(token, TokenPrefix) {
+		return model.Actor{}, fmt.Errorf("API key has no %s prefix: %w", TokenPrefix, model.ErrUnauthorized)
+	}
+
+	key, err := u.Key.GetByHash(ctx, Hash(token))
+	if errors.Is(err, model.ErrNotFound) {
+		return model.Actor{}, fmt.Errorf("API key is unknown: %w", model.ErrUnauthorized)
+	}
+	if err != nil {
+		return model.Actor{}, err
+	}
+	if key.RevokedAt != nil {
+		return model.Actor{}, fmt.Errorf("API key %s is revoked: %w", key.ID, model.ErrUnauthorized)
+	}
+	return model.Actor{
+		ID: key.ID, Name: key.Name, Org: key.Org, UserID: "", Role: "", Team: "", Scopes: key.Scopes,
+	}, nil
+}
+
+// Bearer authenticates bearer tokens of both kinds: API keys are told by their prefix,
+// other tokens are JWT of engineers.
+type Bearer struct {
+	Keys tokenAuthenticator
+	// Users authenticates JWT, nil if it is disabled.
+	Users tokenAuthenticator
+}
+
+type tokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (model.Actor, error)
+}
+
+// Authenticate returns actor of token. Without Users every token is checked as API key.
+func (u *Bearer) Authenticate(ctx context.Context, token string) (model.Actor, error) {
+	if u.Users == nil || strings.HasPrefix(token, TokenPrefix) {
+		return u.Keys.Authenticate(ctx, token)
+	}
+	return u.Users.Authenticate(ctx, token)
+}
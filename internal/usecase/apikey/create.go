@@ -0,0 +1,82 @@
+// Package apikey provides use cases for issuing API keys and authenticating requests with them.
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// TokenPrefix starts every token, so leaked tokens are easy to find by secret scanners.
+const TokenPrefix = "rm_"
+
+const (
+	idSize     = 8
+	secretSize = 32
+)
+
+// Creator provides use case for issuing new API key.
+type Creator struct {
+	Key keyAdderRepo
+}
+
+type keyAdderRepo interface {
+	Add(ctx context.Context, key model.APIKey, hash []byte) (model.APIKey, error)
+}
+
+// Create issues key with given scopes in organization of context.
+// Token is returned only here, repository keeps its hash.
+func (u *Creator) Create(ctx context.Context, name string, scopes []model.Scope) (model.IssuedAPIKey, error) {
+	ctx, span := telemetry.Start(ctx, "apikey.Create")
+	defer span.End()
+
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageAll() {
+		return model.IssuedAPIKey{}, telemetry.Error(span, fmt.Errorf("create API key: %w", model.ErrForbidden))
+	}
+	if len(name) == 0 {
+		return model.IssuedAPIKey{}, telemetry.Error(span, fmt.Errorf("name is empty: %w", model.ErrBadRequest))
+	}
+	if len(scopes) == 0 {
+		return model.IssuedAPIKey{}, telemetry.Error(span, fmt.Errorf("scopes are empty: %w", model.ErrBadRequest))
+	}
+	for _, s := range scopes {
+		if !slices.Contains(model.Scopes(), s) {
+			return model.IssuedAPIKey{}, telemetry.Error(span, fmt.Errorf("unknown scope %q: %w", s, model.ErrBadRequest))
+		}
+	}
+
+	id := make([]byte, idSize)
+	secret := make([]byte, secretSize)
+	_, _ = rand.Read(id)
+	_, _ = rand.Read(secret)
+	key := model.APIKey{
+		ID:        hex.EncodeToString(id),
+		Name:      name,
+		Org:       model.OrgFrom(ctx),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		CreatedAt: time.Time{},
+		RevokedAt: nil,
+	}
+	token := TokenPrefix + key.ID + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	span.SetAttributes(telemetry.APIKey(key.ID))
+
+	key, err := u.Key.Add(ctx, key, Hash(token))
+	if err != nil {
+		return model.IssuedAPIKey{}, telemetry.Error(span, err)
+	}
+	return model.IssuedAPIKey{Key: key, Token: token}, nil
+}
+
+// Hash is what repository stores instead of token. Tokens are random, so salt is not needed.
+func Hash(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
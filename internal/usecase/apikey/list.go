@@ -0,0 +1,34 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Lister provides use case for listing API keys.
+type Lister struct {
+	Key keyListerRepo
+}
+
+type keyListerRepo interface {
+	List(ctx context.Context) ([]model.APIKey, error)
+}
+
+// List returns every key including revoked ones, tokens are never returned.
+func (u *Lister) List(ctx context.Context) ([]model.APIKey, error) {
+	ctx, span := telemetry.Start(ctx, "apikey.List")
+	defer span.End()
+
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageAll() {
+		return nil, telemetry.Error(span, fmt.Errorf("list API keys: %w", model.ErrForbidden))
+	}
+
+	keys, err := u.Key.List(ctx)
+	if err != nil {
+		return nil, telemetry.Error(span, err)
+	}
+	return keys, nil
+}
@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Revoker provides use case for revoking API key.
+type Revoker struct {
+	Key keyRevokerRepo
+}
+
+type keyRevokerRepo interface {
+	Revoke(ctx context.Context, id string) (model.APIKey, error)
+}
+
+// Revoke revokes key, so its token is rejected by following requests.
+func (u *Revoker) Revoke(ctx context.Context, id string) (model.APIKey, error) {
+	ctx, span := telemetry.Start(ctx, "apikey.Revoke", telemetry.APIKey(id))
+	defer span.End()
+
+	if len(id) == 0 {
+		return model.APIKey{}, model.ErrBadRequest
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageAll() {
+		return model.APIKey{}, telemetry.Error(span, fmt.Errorf("revoke API key: %w", model.ErrForbidden))
+	}
+
+	key, err := u.Key.Revoke(ctx, id)
+	if err != nil {
+		return model.APIKey{}, telemetry.Error(span, err)
+	}
+	return key, nil
+}
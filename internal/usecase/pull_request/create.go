@@ -7,6 +7,7 @@ import (
 
 	"github.com/LeonovDS/review-manager/internal/database"
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 )
 
 // Creator provides use case for creating pull request.
@@ -14,6 +15,12 @@ type Creator struct {
 	TX   database.TransactionManager
 	PR   prCreatorRepo
 	User userRepo
+	// Reviewers is number of reviewers assigned to new pull request, zero disables assignment.
+	Reviewers int
+	// Metrics receives assignment outcomes, may be nil.
+	Metrics assignmentRecorder
+	// Events publishes assigned reviewers, may be nil.
+	Events assignmentPublisher
 }
 
 type prCreatorRepo interface {
@@ -21,22 +28,33 @@ type prCreatorRepo interface {
 	AssignReviewers(ctx context.Context, prID string, reviewers []string) error
 }
 
+// assignmentRecorder observes committed assignments, e.g. for metrics.
+type assignmentRecorder interface {
+	Assigned(count int)
+	Reassigned(org, team string)
+	NoCandidate()
+}
+
 type userRepo interface {
 	Get(ctx context.Context, id string) (model.User, error)
 	GetActiveTeamMembers(ctx context.Context, user model.User) ([]string, error)
 }
 
-const maxReviewers int = 2
+// DefaultReviewers is number of reviewers assigned to new pull request by default.
+const DefaultReviewers int = 2
 
 // Create validates request and saves pull request into repository.
 func (u *Creator) Create(ctx context.Context, id, name, author string) (model.PullRequest, error) {
+	ctx, span := telemetry.Start(ctx, "pullrequest.Create", telemetry.PullRequest(id), telemetry.User(author))
+	defer span.End()
+
 	err := validatePR(id, name, author)
 	if err != nil {
-		return model.PullRequest{}, err
+		return model.PullRequest{}, telemetry.Error(span, err)
 	}
 
 	var pr model.PullRequest
-	err = u.TX.WithTransaction(ctx, func(context.Context) error {
+	err = u.TX.WithTransaction(ctx, func(ctx context.Context) error {
 		authorUser, err := u.User.Get(ctx, author)
 		if err != nil {
 			return err
@@ -47,17 +65,7 @@ func (u *Creator) Create(ctx context.Context, id, name, author string) (model.Pu
 			return err
 		}
 
-		reviewers := make([]string, 0, maxReviewers)
-		switch len(teamMembers) {
-		case 0:
-			break
-		case 1:
-			reviewers = append(reviewers, teamMembers[0])
-		default:
-			i1, i2 := randomPair(len(teamMembers))
-			reviewers = append(reviewers, teamMembers[i1])
-			reviewers = append(reviewers, teamMembers[i2])
-		}
+		reviewers := pickReviewers(teamMembers, u.Reviewers)
 
 		pr, err = u.PR.Create(ctx, id, name, author)
 		if err != nil {
@@ -70,23 +78,33 @@ func (u *Creator) Create(ctx context.Context, id, name, author string) (model.Pu
 		}
 
 		pr.Reviewers = reviewers
-		return nil
+		events := make([]model.AssignmentEvent, 0, len(reviewers))
+		for _, reviewer := range reviewers {
+			e := newEvent(ctx, model.AssignmentAssigned, pr, authorUser.TeamName)
+			e.ReviewerID = reviewer
+			events = append(events, e)
+		}
+		return publish(ctx, u.Events, events...)
 	})
 	if err != nil {
-		return model.PullRequest{}, err
+		return model.PullRequest{}, telemetry.Error(span, err)
 	}
 
+	if u.Metrics != nil {
+		u.Metrics.Assigned(len(pr.Reviewers))
+	}
 	return pr, nil
 }
 
+// pickReviewers selects up to count distinct random members.
 // #nosec G404 - there is no need for secure random
-func randomPair(n int) (int, int) {
-	a := rand.Intn(n)
-	b := a
-	for b == a {
-		b = rand.Intn(n)
+func pickReviewers(members []string, count int) []string {
+	count = min(count, len(members))
+	reviewers := make([]string, 0, count)
+	for _, i := range rand.Perm(len(members))[:count] {
+		reviewers = append(reviewers, members[i])
 	}
-	return a, b
+	return reviewers
 }
 
 func validatePR(id, name, author string) error {
@@ -0,0 +1,43 @@
+package pullrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// assignmentPublisher delivers assignment events to watchers when transaction is committed.
+type assignmentPublisher interface {
+	Publish(ctx context.Context, events ...model.AssignmentEvent) error
+}
+
+// newEvent describes change of pull request made now in organization of ctx.
+// Team is team of reviewers.
+func newEvent(ctx context.Context, kind string, pr model.PullRequest, team string) model.AssignmentEvent {
+	return model.AssignmentEvent{
+		Type:       kind,
+		Org:        model.OrgFrom(ctx),
+		PRID:       pr.ID,
+		AuthorID:   pr.AuthorID,
+		TeamName:   team,
+		ReviewerID: "",
+		PreviousID: "",
+		Time:       time.Now().UTC(),
+	}
+}
+
+// reassignedEvent describes reviewer replaced inside team.
+func reassignedEvent(ctx context.Context, pr model.PullRequest, team, oldID, newID string) model.AssignmentEvent {
+	e := newEvent(ctx, model.AssignmentReassigned, pr, team)
+	e.ReviewerID, e.PreviousID = newID, oldID
+	return e
+}
+
+// publish sends events with publisher that may be nil.
+func publish(ctx context.Context, publisher assignmentPublisher, events ...model.AssignmentEvent) error {
+	if publisher == nil || len(events) == 0 {
+		return nil
+	}
+	return publisher.Publish(ctx, events...)
+}
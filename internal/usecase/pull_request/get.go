@@ -0,0 +1,29 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Getter provides use case for getting a single pull request.
+type Getter struct {
+	PR prGetterRepo
+}
+
+type prGetterRepo interface {
+	Get(ctx context.Context, id string) (model.PullRequest, error)
+}
+
+// Get queries repository for pull request with reviewers.
+func (u *Getter) Get(ctx context.Context, id string) (model.PullRequest, error) {
+	ctx, span := telemetry.Start(ctx, "pullrequest.Get", telemetry.PullRequest(id))
+	defer span.End()
+
+	if len(id) == 0 {
+		return model.PullRequest{}, model.ErrBadRequest
+	}
+
+	return u.PR.Get(ctx, id)
+}
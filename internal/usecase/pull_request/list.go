@@ -0,0 +1,99 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Lister provides use case for listing and filtering pull requests.
+type Lister struct {
+	TX database.TransactionManager
+	PR prListerRepo
+}
+
+type prListerRepo interface {
+	List(ctx context.Context, filter model.PullRequestFilter) ([]model.PullRequest, error)
+	Count(ctx context.Context, filter model.PullRequestFilter) (int, error)
+}
+
+const (
+	defaultPageSize int = 50
+	maxPageSize     int = 200
+)
+
+// List validates filter and returns one page of matching pull requests starting after cursor.
+func (u *Lister) List(
+	ctx context.Context, filter model.PullRequestFilter, cursor string,
+) (model.PullRequestPage, error) {
+	ctx, span := telemetry.Start(ctx, "pullrequest.List")
+	defer span.End()
+
+	err := validateFilter(&filter)
+	if err != nil {
+		return model.PullRequestPage{}, telemetry.Error(span, err)
+	}
+
+	if len(cursor) != 0 {
+		after, err := model.DecodeCursor(cursor)
+		if err != nil {
+			return model.PullRequestPage{}, err
+		}
+		filter.After = &after
+	}
+
+	pageSize := filter.Limit
+	filter.Limit = pageSize + 1
+	var prs []model.PullRequest
+	var total int
+	// Page and total are read from one snapshot, so they agree while pull requests are created.
+	err = u.TX.WithTransaction(database.Snapshot(ctx), func(ctx context.Context) error {
+		var err error
+		prs, err = u.PR.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+		total, err = u.PR.Count(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return model.PullRequestPage{}, telemetry.Error(span, err)
+	}
+
+	page := model.PullRequestPage{PullRequests: prs, NextCursor: "", Total: total}
+	if len(prs) > pageSize {
+		page.PullRequests = prs[:pageSize]
+		last := page.PullRequests[pageSize-1]
+		if last.CreatedAt != nil {
+			page.NextCursor = model.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}.Encode()
+		}
+	}
+	return page, nil
+}
+
+func validateFilter(filter *model.PullRequestFilter) error {
+	switch filter.Status {
+	case "", "OPEN", "MERGED":
+	default:
+		return model.ErrBadRequest
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageSize {
+		return model.ErrBadRequest
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil &&
+		filter.CreatedFrom.After(*filter.CreatedTo) {
+		return model.ErrBadRequest
+	}
+	if filter.MergedFrom != nil && filter.MergedTo != nil &&
+		filter.MergedFrom.After(*filter.MergedTo) {
+		return model.ErrBadRequest
+	}
+	return nil
+}
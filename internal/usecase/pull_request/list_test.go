@@ -0,0 +1,121 @@
+package pullrequest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//nolint:gochecknoglobals
+var (
+	errInternal = errors.New("internal error")
+	createdAt   = time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	noPage      model.PullRequestPage
+)
+
+type prListMockRepo struct {
+	mock.Mock
+}
+
+func (m *prListMockRepo) List(
+	_ context.Context, filter model.PullRequestFilter,
+) ([]model.PullRequest, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]model.PullRequest), args.Error(1)
+}
+
+func (m *prListMockRepo) Count(_ context.Context, filter model.PullRequestFilter) (int, error) {
+	args := m.Called(filter)
+	return args.Int(0), args.Error(1)
+}
+
+func samplePR(id string) model.PullRequest {
+	return model.PullRequest{
+		ID:        id,
+		Name:      "PR " + id,
+		AuthorID:  "u1",
+		Status:    "OPEN",
+		Reviewers: []string{"u2"},
+		CreatedAt: &createdAt,
+		MergedAt:  nil,
+	}
+}
+
+func TestPRList_Validation(t *testing.T) {
+	var u pullrequest.Lister
+	later := createdAt.Add(time.Hour)
+
+	type testCase struct {
+		testName string
+		filter   model.PullRequestFilter
+		cursor   string
+	}
+
+	tests := []testCase{
+		{testName: "Unknown status", filter: model.PullRequestFilter{Status: "CLOSED"}},
+		{testName: "Negative limit", filter: model.PullRequestFilter{Limit: -1}},
+		{testName: "Too big limit", filter: model.PullRequestFilter{Limit: 1000}},
+		{
+			testName: "Inverted created range",
+			filter:   model.PullRequestFilter{CreatedFrom: &later, CreatedTo: &createdAt},
+		},
+		{testName: "Malformed cursor", cursor: "not a cursor"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			page, err := u.List(t.Context(), test.filter, test.cursor)
+			assert.ErrorIs(t, err, model.ErrBadRequest)
+			assert.Equal(t, noPage, page)
+		})
+	}
+}
+
+//nolint:exhaustruct
+func TestPRList_Pagination(t *testing.T) {
+	repo := new(prListMockRepo)
+	prs := []model.PullRequest{samplePR("pr1"), samplePR("pr2"), samplePR("pr3")}
+	repo.On("List", model.PullRequestFilter{Status: "OPEN", Limit: 3}).Return(prs, nil)
+	repo.On("Count", mock.Anything).Return(5, nil)
+
+	u := pullrequest.Lister{TX: &fakeTransactionManager{}, PR: repo}
+	page, err := u.List(t.Context(), model.PullRequestFilter{Status: "OPEN", Limit: 2}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, prs[:2], page.PullRequests)
+	assert.Equal(t, 5, page.Total)
+
+	cursor, err := model.DecodeCursor(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Cursor{CreatedAt: createdAt, ID: "pr2"}, cursor)
+}
+
+//nolint:exhaustruct
+func TestPRList_LastPage(t *testing.T) {
+	repo := new(prListMockRepo)
+	cursor := model.Cursor{CreatedAt: createdAt, ID: "pr2"}
+	prs := []model.PullRequest{samplePR("pr3")}
+	repo.On("List", model.PullRequestFilter{After: &cursor, Limit: 51}).Return(prs, nil)
+	repo.On("Count", mock.Anything).Return(3, nil)
+
+	u := pullrequest.Lister{TX: &fakeTransactionManager{}, PR: repo}
+	page, err := u.List(t.Context(), model.PullRequestFilter{}, cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, model.PullRequestPage{PullRequests: prs, NextCursor: "", Total: 3}, page)
+}
+
+//nolint:exhaustruct
+func TestPRList_Errors(t *testing.T) {
+	repo := new(prListMockRepo)
+	repo.On("List", mock.Anything).Return([]model.PullRequest{}, errInternal)
+
+	u := pullrequest.Lister{TX: &fakeTransactionManager{}, PR: repo}
+	page, err := u.List(t.Context(), model.PullRequestFilter{}, "")
+	assert.ErrorIs(t, err, errInternal)
+	assert.Equal(t, noPage, page)
+}
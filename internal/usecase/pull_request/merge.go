@@ -4,12 +4,18 @@ import (
 	"context"
 	"errors"
 
+	"github.com/LeonovDS/review-manager/internal/database"
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 )
 
 // Merger provides use case for merging pull request.
 type Merger struct {
-	PR prMergerRepo
+	TX   database.TransactionManager
+	PR   prMergerRepo
+	User userRepo
+	// Events publishes merged pull requests, may be nil.
+	Events assignmentPublisher
 }
 
 type prMergerRepo interface {
@@ -19,18 +25,43 @@ type prMergerRepo interface {
 
 // Merge marks pull request as merged and returns it.
 func (u *Merger) Merge(ctx context.Context, id string) (model.PullRequest, error) {
+	ctx, span := telemetry.Start(ctx, "pullrequest.Merge", telemetry.PullRequest(id))
+	defer span.End()
+
 	if len(id) == 0 {
 		return model.PullRequest{}, model.ErrBadRequest
 	}
 
-	err := u.PR.Merge(ctx, id)
-	if err != nil && !errors.Is(err, model.ErrNotFound) {
-		return model.PullRequest{}, err
-	}
+	var pr model.PullRequest
+	err := u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		merged := u.PR.Merge(ctx, id)
+		if merged != nil && !errors.Is(merged, model.ErrNotFound) {
+			return merged
+		}
+
+		var err error
+		pr, err = u.PR.Get(ctx, id)
+		// Merging already merged pull request changes nothing, so it is not published again.
+		if err != nil || merged != nil || u.Events == nil {
+			return err
+		}
 
-	pr, err := u.PR.Get(ctx, id)
+		author, err := u.User.Get(ctx, pr.AuthorID)
+		if err != nil {
+			return err
+		}
+		// Every reviewer is told that review is done, pull request without reviewers is still published.
+		events := []model.AssignmentEvent{newEvent(ctx, model.AssignmentMerged, pr, author.TeamName)}
+		for i, reviewer := range pr.Reviewers {
+			if i != 0 {
+				events = append(events, events[0])
+			}
+			events[i].ReviewerID = reviewer
+		}
+		return publish(ctx, u.Events, events...)
+	})
 	if err != nil {
-		return model.PullRequest{}, err
+		return model.PullRequest{}, telemetry.Error(span, err)
 	}
 	return pr, nil
 }
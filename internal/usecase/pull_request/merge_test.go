@@ -0,0 +1,50 @@
+package pullrequest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
+	"github.com/stretchr/testify/assert"
+)
+
+type prMergeMockRepo struct {
+	prReleaseMockRepo
+}
+
+func (m *prMergeMockRepo) Merge(_ context.Context, id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+//nolint:exhaustruct
+func TestMerge_Events(t *testing.T) {
+	pr := model.PullRequest{ID: "pr-1", Name: "Fix", AuthorID: "u1", Status: "MERGED", Reviewers: []string{"u2", "u3"}}
+	prRepo := &prMergeMockRepo{}
+	userRepo := &userMockRepo{}
+	_ = prRepo.On("Merge", "pr-1").Return(nil).Once()
+	_ = prRepo.On("Merge", "pr-1").Return(model.ErrNotFound)
+	_ = prRepo.On("Get", "pr-1").Return(pr, nil)
+	_ = userRepo.On("Get", "u1").Return(model.User{UserID: "u1", TeamName: "backend"}, nil)
+	events := &eventRecorder{}
+	u := pullrequest.Merger{TX: &fakeTransactionManager{}, PR: prRepo, User: userRepo, Events: events}
+
+	_, err := u.Merge(t.Context(), "pr-1")
+	assert.NoError(t, err)
+
+	// Every reviewer gets own event.
+	if assert.Len(t, events.events, 2) {
+		for i, reviewer := range pr.Reviewers {
+			assert.Equal(t, model.AssignmentMerged, events.events[i].Type)
+			assert.Equal(t, "backend", events.events[i].TeamName)
+			assert.Equal(t, reviewer, events.events[i].ReviewerID)
+		}
+	}
+
+	// Merging again returns pull request, but publishes nothing.
+	got, err := u.Merge(t.Context(), "pr-1")
+	assert.NoError(t, err)
+	assert.Equal(t, pr, got)
+	assert.Len(t, events.events, 2)
+}
@@ -2,11 +2,15 @@ package pullrequest
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"math/rand"
 	"slices"
 
 	"github.com/LeonovDS/review-manager/internal/database"
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 )
 
 // Reassigner provides use case for reassigning pull requests.
@@ -14,6 +18,10 @@ type Reassigner struct {
 	TX   database.TransactionManager
 	PR   prReassignerRepo
 	User userRepo
+	// Metrics receives reassignment outcomes, may be nil.
+	Metrics assignmentRecorder
+	// Events publishes new reviewers, may be nil.
+	Events assignmentPublisher
 }
 
 type prReassignerRepo interface {
@@ -22,13 +30,18 @@ type prReassignerRepo interface {
 }
 
 // Reassign checks if user is actual reviewer of pull request and finds active team member who can review PR instead.
-func (u *Reassigner) Reassign(ctx context.Context, r model.Reviewer) (model.Reviewer, error) {
+// Returns pull request with new reviewer.
+func (u *Reassigner) Reassign(ctx context.Context, r model.Reviewer) (model.Reassignment, error) {
+	ctx, span := telemetry.Start(ctx, "pullrequest.Reassign", telemetry.PullRequest(r.PRID), telemetry.User(r.UID))
+	defer span.End()
+
 	if len(r.PRID) == 0 || len(r.UID) == 0 {
-		return model.Reviewer{}, model.ErrBadRequest
+		return model.Reassignment{}, model.ErrBadRequest
 	}
 
-	var reviewer model.Reviewer
-	err := u.TX.WithTransaction(ctx, func(context.Context) error {
+	var res model.Reassignment
+	var teamName string
+	err := u.TX.WithTransaction(ctx, func(ctx context.Context) error {
 		user, err := u.User.Get(ctx, r.UID)
 		if err != nil {
 			return err
@@ -38,6 +51,9 @@ func (u *Reassigner) Reassign(ctx context.Context, r model.Reviewer) (model.Revi
 		if err != nil {
 			return err
 		}
+		if actor, _ := model.ActorFrom(ctx); !actor.CanReassign(pr, user.TeamName) {
+			return fmt.Errorf("reassign reviews of %s: %w", pr.ID, model.ErrForbidden)
+		}
 		if pr.Status == "MERGED" {
 			return model.ErrPRMerged
 		}
@@ -50,30 +66,54 @@ func (u *Reassigner) Reassign(ctx context.Context, r model.Reviewer) (model.Revi
 			return err
 		}
 
-		team = slices.DeleteFunc(team, func(it string) bool {
-			return slices.Contains(pr.Reviewers, it) || it == pr.AuthorID
-		})
-
-		if len(team) == 0 {
+		newID, ok := pickCandidate(team, pr)
+		if !ok {
 			return model.ErrNoCandidate
 		}
 
-		// #nosec G404
-		newID := team[rand.Intn(len(team))]
 		err = u.PR.UpdateReviewer(ctx, pr.ID, r.UID, newID)
 		if err != nil {
 			return err
 		}
 
-		reviewer = model.Reviewer{
-			PRID: r.PRID,
-			UID:  newID,
+		pr.Reviewers[slices.Index(pr.Reviewers, r.UID)] = newID
+		res = model.Reassignment{
+			PR:         pr,
+			ReplacedBy: newID,
 		}
-		return nil
+		teamName = user.TeamName
+		return publish(ctx, u.Events, reassignedEvent(ctx, pr, user.TeamName, r.UID, newID))
 	})
+	if u.Metrics != nil {
+		switch {
+		case err == nil:
+			u.Metrics.Reassigned(model.OrgFrom(ctx), teamName)
+		case errors.Is(err, model.ErrNoCandidate):
+			u.Metrics.NoCandidate()
+		}
+	}
 	if err != nil {
-		return model.Reviewer{}, err
+		return model.Reassignment{}, telemetry.Error(span, err)
+	}
+
+	slog.InfoContext(ctx, "Reviewer reassigned",
+		slog.String("pull_request_id", r.PRID),
+		slog.String("old_reviewer_id", r.UID),
+		slog.String("new_reviewer_id", res.ReplacedBy),
+	)
+	return res, nil
+}
+
+// pickCandidate chooses random team member who is neither reviewer nor author of pull request.
+func pickCandidate(team []string, pr model.PullRequest) (string, bool) {
+	candidates := slices.DeleteFunc(slices.Clone(team), func(it string) bool {
+		return slices.Contains(pr.Reviewers, it) || it == pr.AuthorID
+	})
+
+	if len(candidates) == 0 {
+		return "", false
 	}
 
-	return reviewer, nil
+	// #nosec G404
+	return candidates[rand.Intn(len(candidates))], true
 }
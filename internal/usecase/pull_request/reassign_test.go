@@ -0,0 +1,106 @@
+package pullrequest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (m *prReleaseMockRepo) Get(_ context.Context, id string) (model.PullRequest, error) {
+	args := m.Called(id)
+	return args.Get(0).(model.PullRequest), args.Error(1)
+}
+
+type fakeTransactionManager struct{}
+
+func (tm *fakeTransactionManager) WithTransaction(
+	ctx context.Context, transaction func(context.Context) error,
+) error {
+	return transaction(ctx)
+}
+
+//nolint:exhaustruct
+func TestReassign_Access(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   model.Actor
+		allowed bool
+	}{
+		{name: "author", actor: model.Actor{UserID: "u1", Role: model.RoleMember, Team: "team1"}, allowed: true},
+		{name: "reviewer", actor: model.Actor{UserID: "u2", Role: model.RoleMember, Team: "team1"}, allowed: true},
+		{name: "other member", actor: model.Actor{UserID: "u3", Role: model.RoleMember, Team: "team1"}},
+		{name: "lead of reviewer", actor: model.Actor{UserID: "u4", Role: model.RoleTeamLead, Team: "team1"},
+			allowed: true},
+		{name: "lead of other team", actor: model.Actor{UserID: "u5", Role: model.RoleTeamLead, Team: "team2"}},
+		{name: "admin", actor: model.Actor{UserID: "u6", Role: model.RoleAdmin, Team: "team2"}, allowed: true},
+		{name: "API key", actor: model.Actor{ID: "k1"}, allowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := model.PullRequest{ID: "pr-1", Name: "Fix", AuthorID: "u1", Status: "OPEN", Reviewers: []string{"u2"}}
+			prRepo := &prReleaseMockRepo{}
+			userRepo := &userMockRepo{}
+			_ = prRepo.On("Get", "pr-1").Return(pr, nil)
+			_ = prRepo.On("UpdateReviewer", "pr-1", "u2", "u3").Return(nil)
+			_ = userRepo.On("Get", "u2").Return(leaving, nil)
+			_ = userRepo.On("GetActiveTeamMembers", mock.Anything).Return([]string{"u1", "u2", "u3"}, nil)
+			u := pullrequest.Reassigner{TX: &fakeTransactionManager{}, PR: prRepo, User: userRepo}
+
+			_, err := u.Reassign(model.WithActor(t.Context(), tt.actor), model.Reviewer{PRID: "pr-1", UID: "u2"})
+
+			if tt.allowed {
+				assert.NoError(t, err)
+				prRepo.AssertCalled(t, "UpdateReviewer", "pr-1", "u2", "u3")
+			} else {
+				assert.ErrorIs(t, err, model.ErrForbidden)
+				prRepo.AssertNotCalled(t, "UpdateReviewer", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+type eventRecorder struct {
+	events []model.AssignmentEvent
+}
+
+func (r *eventRecorder) Publish(_ context.Context, events ...model.AssignmentEvent) error {
+	r.events = append(r.events, events...)
+	return nil
+}
+
+//nolint:exhaustruct
+func TestReassign_Events(t *testing.T) {
+	pr := model.PullRequest{ID: "pr-1", Name: "Fix", AuthorID: "u1", Status: "OPEN", Reviewers: []string{"u2"}}
+	prRepo := &prReleaseMockRepo{}
+	userRepo := &userMockRepo{}
+	_ = prRepo.On("Get", "pr-1").Return(pr, nil)
+	_ = prRepo.On("UpdateReviewer", "pr-1", "u2", "u3").Return(nil)
+	_ = userRepo.On("Get", "u2").Return(leaving, nil)
+	_ = userRepo.On("Get", "u1").Return(model.User{UserID: "u1", TeamName: leaving.TeamName}, nil)
+	_ = userRepo.On("GetActiveTeamMembers", mock.Anything).Return([]string{"u1", "u2", "u3"}, nil)
+	events := &eventRecorder{}
+	u := pullrequest.Reassigner{TX: &fakeTransactionManager{}, PR: prRepo, User: userRepo, Events: events}
+
+	_, err := u.Reassign(model.WithOrg(t.Context(), "acme"), model.Reviewer{PRID: "pr-1", UID: "u2"})
+	assert.NoError(t, err)
+
+	if assert.Len(t, events.events, 1) {
+		e := events.events[0]
+		assert.Equal(t, model.AssignmentReassigned, e.Type)
+		assert.Equal(t, "acme", e.Org)
+		assert.Equal(t, "pr-1", e.PRID)
+		assert.Equal(t, "u1", e.AuthorID)
+		assert.Equal(t, leaving.TeamName, e.TeamName)
+		assert.Equal(t, "u3", e.ReviewerID)
+		assert.Equal(t, "u2", e.PreviousID)
+	}
+
+	// Failed reassignment publishes nothing.
+	_, err = u.Reassign(t.Context(), model.Reviewer{PRID: "pr-1", UID: "u1"})
+	assert.ErrorIs(t, err, model.ErrNotAssigned)
+	assert.Len(t, events.events, 1)
+}
@@ -0,0 +1,94 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// ReviewReleaser provides use case for handling open reviews of user leaving team.
+// It is meant to be called inside transaction of the membership change.
+type ReviewReleaser struct {
+	PR   prReleaserRepo
+	User userRepo
+	// Events publishes reassigned reviews, may be nil.
+	Events assignmentPublisher
+	// Metrics counts reassigned reviews, may be nil. Releaser runs inside transaction of caller,
+	// so they are counted before it commits.
+	Metrics assignmentRecorder
+}
+
+type prReleaserRepo interface {
+	List(ctx context.Context, filter model.PullRequestFilter) ([]model.PullRequest, error)
+	UpdateReviewer(ctx context.Context, prID, oUID, nUID string) error
+	RemoveReviewer(ctx context.Context, prID, uID string) error
+}
+
+// Release goes through open pull requests reviewed by user.
+// If reassign is set, each review is given to active member of user's former team,
+// or removed, if there is no candidate. Otherwise reviews are kept.
+// User must contain team it had before the change.
+func (u *ReviewReleaser) Release(
+	ctx context.Context, user model.User, reassign bool,
+) ([]model.ReviewChange, error) {
+	ctx, span := telemetry.Start(ctx, "pullrequest.Release", telemetry.User(user.UserID), telemetry.Team(user.TeamName))
+	defer span.End()
+
+	var filter model.PullRequestFilter
+	filter.Status = "OPEN"
+	filter.ReviewerID = user.UserID
+	prs, err := u.PR.List(ctx, filter)
+	if err != nil {
+		return nil, telemetry.Error(span, err)
+	}
+
+	var team []string
+	if reassign && len(prs) != 0 {
+		team, err = u.User.GetActiveTeamMembers(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make([]model.ReviewChange, 0, len(prs))
+	var events []model.AssignmentEvent
+	for _, pr := range prs {
+		change := model.ReviewChange{
+			PRID:      pr.ID,
+			OldUserID: user.UserID,
+			NewUserID: "",
+			Action:    model.ReviewKept,
+		}
+		if reassign {
+			change, err = u.reassign(ctx, pr, change, team)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if change.Action == model.ReviewReassigned {
+			events = append(events, reassignedEvent(ctx, pr, user.TeamName, change.OldUserID, change.NewUserID))
+		}
+		changes = append(changes, change)
+	}
+	if u.Metrics != nil {
+		for _, e := range events {
+			u.Metrics.Reassigned(e.Org, e.TeamName)
+		}
+	}
+	return changes, publish(ctx, u.Events, events...)
+}
+
+func (u *ReviewReleaser) reassign(
+	ctx context.Context, pr model.PullRequest, change model.ReviewChange, team []string,
+) (model.ReviewChange, error) {
+	newID, ok := pickCandidate(team, pr)
+	if !ok {
+		change.Action = model.ReviewRemoved
+		return change, u.PR.RemoveReviewer(ctx, pr.ID, change.OldUserID)
+	}
+
+	change.Action = model.ReviewReassigned
+	change.NewUserID = newID
+	return change, u.PR.UpdateReviewer(ctx, pr.ID, change.OldUserID, newID)
+}
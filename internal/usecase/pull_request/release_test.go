@@ -0,0 +1,119 @@
+package pullrequest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	pullrequest "github.com/LeonovDS/review-manager/internal/usecase/pull_request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type prReleaseMockRepo struct {
+	prListMockRepo
+}
+
+func (m *prReleaseMockRepo) UpdateReviewer(_ context.Context, prID, oUID, nUID string) error {
+	args := m.Called(prID, oUID, nUID)
+	return args.Error(0)
+}
+
+func (m *prReleaseMockRepo) RemoveReviewer(_ context.Context, prID, uID string) error {
+	args := m.Called(prID, uID)
+	return args.Error(0)
+}
+
+type userMockRepo struct {
+	mock.Mock
+}
+
+func (m *userMockRepo) Get(_ context.Context, id string) (model.User, error) {
+	args := m.Called(id)
+	return args.Get(0).(model.User), args.Error(1)
+}
+
+func (m *userMockRepo) GetActiveTeamMembers(_ context.Context, user model.User) ([]string, error) {
+	args := m.Called(user)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+//nolint:gochecknoglobals
+var leaving = model.User{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team1"}
+
+//nolint:exhaustruct
+func openReviews() model.PullRequestFilter {
+	return model.PullRequestFilter{Status: "OPEN", ReviewerID: "u2"}
+}
+
+// metricsRecorder records organizations and teams of reassigned reviews.
+type metricsRecorder struct {
+	reassigned []string
+}
+
+func (r *metricsRecorder) Assigned(int) {}
+
+func (r *metricsRecorder) Reassigned(org, team string) {
+	r.reassigned = append(r.reassigned, org+"/"+team)
+}
+
+func (r *metricsRecorder) NoCandidate() {}
+
+func TestReviewRelease_Keep(t *testing.T) {
+	prRepo := new(prReleaseMockRepo)
+	prRepo.On("List", openReviews()).Return([]model.PullRequest{samplePR("pr1")}, nil)
+
+	u := pullrequest.ReviewReleaser{PR: prRepo, User: new(userMockRepo)}
+	changes, err := u.Release(t.Context(), leaving, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ReviewChange{
+		{PRID: "pr1", OldUserID: "u2", NewUserID: "", Action: model.ReviewKept},
+	}, changes)
+	prRepo.AssertExpectations(t)
+}
+
+func TestReviewRelease_Reassign(t *testing.T) {
+	withCandidate := samplePR("pr1")
+	withCandidate.Reviewers = []string{"u2"}
+	noCandidate := samplePR("pr2")
+	noCandidate.Reviewers = []string{"u2", "u3"}
+
+	prRepo := new(prReleaseMockRepo)
+	userRepo := new(userMockRepo)
+	prRepo.On("List", openReviews()).Return([]model.PullRequest{withCandidate, noCandidate}, nil)
+	userRepo.On("GetActiveTeamMembers", leaving).Return([]string{"u1", "u3"}, nil)
+	prRepo.On("UpdateReviewer", "pr1", "u2", "u3").Return(nil)
+	prRepo.On("RemoveReviewer", "pr2", "u2").Return(nil)
+
+	events := &eventRecorder{}
+	metrics := &metricsRecorder{}
+	u := pullrequest.ReviewReleaser{PR: prRepo, User: userRepo, Events: events, Metrics: metrics}
+	changes, err := u.Release(t.Context(), leaving, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ReviewChange{
+		{PRID: "pr1", OldUserID: "u2", NewUserID: "u3", Action: model.ReviewReassigned},
+		{PRID: "pr2", OldUserID: "u2", NewUserID: "", Action: model.ReviewRemoved},
+	}, changes)
+	// Removed reviews are not published, there is no one to notify.
+	if assert.Len(t, events.events, 1) {
+		assert.Equal(t, model.AssignmentReassigned, events.events[0].Type)
+		assert.Equal(t, "u3", events.events[0].ReviewerID)
+		assert.Equal(t, "u2", events.events[0].PreviousID)
+		assert.Equal(t, leaving.TeamName, events.events[0].TeamName)
+	}
+	assert.Equal(t, []string{model.DefaultOrg + "/" + leaving.TeamName}, metrics.reassigned)
+	prRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+func TestReviewRelease_Errors(t *testing.T) {
+	prRepo := new(prReleaseMockRepo)
+	userRepo := new(userMockRepo)
+	prRepo.On("List", openReviews()).Return([]model.PullRequest{samplePR("pr1")}, nil)
+	userRepo.On("GetActiveTeamMembers", leaving).Return([]string{}, errInternal)
+
+	u := pullrequest.ReviewReleaser{PR: prRepo, User: userRepo}
+	changes, err := u.Release(t.Context(), leaving, true)
+	assert.ErrorIs(t, err, errInternal)
+	assert.Empty(t, changes)
+}
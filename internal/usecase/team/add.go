@@ -3,9 +3,12 @@ package team
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/LeonovDS/review-manager/internal/database"
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 )
 
 // Adder provides use case for creating a new team.
@@ -21,21 +24,38 @@ type teamAdderRepo interface {
 
 type userAdderRepo interface {
 	Add(ctx context.Context, team model.Team) error
+	Get(ctx context.Context, id string) (model.User, error)
+}
+
+type userGetterRepo interface {
+	Get(ctx context.Context, id string) (model.User, error)
 }
 
 // Add validates team and stores it into repository.
+// Members of other teams are rejected, they must be moved explicitly.
 func (u *Adder) Add(ctx context.Context, team model.Team) (model.Team, error) {
+	ctx, span := telemetry.Start(ctx, "team.Add", telemetry.Team(team.TeamName))
+	defer span.End()
+
 	err := validate(team)
 	if err != nil {
-		return model.Team{}, err
+		return model.Team{}, telemetry.Error(span, err)
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageAll() {
+		return model.Team{}, telemetry.Error(span, fmt.Errorf("add team: %w", model.ErrForbidden))
 	}
 
-	err = u.TX.WithTransaction(ctx, func(context.Context) error {
+	err = u.TX.WithTransaction(ctx, func(ctx context.Context) error {
 		_, err = u.Team.Add(ctx, team)
 		if err != nil {
 			return err
 		}
 
+		err = checkMembership(ctx, u.User, team)
+		if err != nil {
+			return err
+		}
+
 		err = u.User.Add(ctx, team)
 		if err != nil {
 			return err
@@ -43,27 +63,52 @@ func (u *Adder) Add(ctx context.Context, team model.Team) (model.Team, error) {
 		return nil
 	})
 	if err != nil {
-		return model.Team{}, err
+		return model.Team{}, telemetry.Error(span, err)
 	}
 
 	return team, nil
 }
 
+// checkMembership rejects members that already belong to team other than given one.
+func checkMembership(ctx context.Context, users userGetterRepo, team model.Team) error {
+	for _, m := range team.Members {
+		existing, err := users.Get(ctx, m.UserID)
+		if errors.Is(err, model.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if existing.TeamName != "" && existing.TeamName != team.TeamName {
+			return fmt.Errorf("%s %w %s", m.UserID, model.ErrUserInOtherTeam, existing.TeamName)
+		}
+	}
+	return nil
+}
+
 func validate(team model.Team) error {
 	if len(team.TeamName) == 0 {
-		return model.ErrBadRequest
+		return fmt.Errorf("team_name is empty: %w", model.ErrBadRequest)
 	}
 	if len(team.Members) == 0 {
-		return model.ErrBadRequest
+		return fmt.Errorf("members are empty: %w", model.ErrBadRequest)
 	}
 
 	for _, m := range team.Members {
-		if len(m.UserID) == 0 {
-			return model.ErrBadRequest
-		}
-		if len(m.Username) == 0 {
-			return model.ErrBadRequest
+		err := ValidateMember(m)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// ValidateMember checks user fields required for team membership.
+func ValidateMember(m model.User) error {
+	if len(m.UserID) == 0 {
+		return fmt.Errorf("user_id is empty: %w", model.ErrBadRequest)
+	}
+	if len(m.Username) == 0 {
+		return fmt.Errorf("username is empty: %w", model.ErrBadRequest)
+	}
+	return nil
+}
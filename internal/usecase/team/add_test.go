@@ -108,12 +108,30 @@ func TestTeamAdd(t *testing.T) {
 	teamRepo := new(teamMockRepo)
 	userRepo := new(userMockRepo)
 	teamRepo.On("Add", sampleTeam).Return(sampleTeam, nil)
+	// Existing user without team joins, unknown user is created.
+	userRepo.On("Get", "u1").Return(model.User{UserID: "u1", Username: "Alice", IsActive: false, TeamName: ""}, nil)
+	userRepo.On("Get", "u2").Return(noUser, model.ErrNotFound)
 	userRepo.On("Add", mock.Anything).Return(nil)
 
 	u := team.Adder{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo}
 	team, err := u.Add(t.Context(), sampleTeam)
 	assert.Equal(t, sampleTeam, team)
 	assert.NoError(t, err)
+	userRepo.AssertExpectations(t)
+}
+
+func TestTeamAdd_UserInOtherTeam(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	teamRepo.On("Add", sampleTeam).Return(sampleTeam, nil)
+	userRepo.On("Get", "u1").Return(noUser, model.ErrNotFound)
+	userRepo.On("Get", "u2").Return(model.User{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team2"}, nil)
+
+	u := team.Adder{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo}
+	team, err := u.Add(t.Context(), sampleTeam)
+	assert.ErrorIs(t, err, model.ErrUserInOtherTeam)
+	assert.Equal(t, noTeam, team)
+	userRepo.AssertNotCalled(t, "Add", mock.Anything)
 }
 
 func TestTeamAdd_Errors(t *testing.T) {
@@ -138,6 +156,7 @@ func TestTeamAdd_Errors(t *testing.T) {
 			testName: "UserRepo internal error",
 			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo) {
 				_ = tR.On("Add", sampleTeam).Return(sampleTeam, nil)
+				_ = uR.On("Get", mock.Anything).Return(noUser, model.ErrNotFound)
 				_ = uR.On("Add", mock.Anything).Return(errInternal)
 			},
 			input:       sampleTeam,
@@ -152,6 +171,15 @@ func TestTeamAdd_Errors(t *testing.T) {
 			input:       sampleTeam,
 			expectedErr: model.ErrTeamExists,
 		},
+		{
+			testName: "UserRepo Get internal error",
+			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo) {
+				_ = tR.On("Add", sampleTeam).Return(sampleTeam, nil)
+				_ = uR.On("Get", mock.Anything).Return(noUser, errInternal)
+			},
+			input:       sampleTeam,
+			expectedErr: errInternal,
+		},
 	}
 
 	for _, test := range tests {
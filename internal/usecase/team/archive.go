@@ -0,0 +1,82 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Archiver provides use case for archiving team.
+// Members of archived team are deactivated and never assigned to reviews.
+type Archiver struct {
+	TX      database.TransactionManager
+	Team    teamArchiverRepo
+	User    userArchiverRepo
+	Reviews reviewReleaser
+}
+
+type teamArchiverRepo interface {
+	Get(ctx context.Context, name string) (model.Team, error)
+	Archive(ctx context.Context, name string) error
+}
+
+type userArchiverRepo interface {
+	GetByTeam(ctx context.Context, name string) ([]model.User, error)
+	DeactivateTeam(ctx context.Context, teamName string) error
+}
+
+// Archive marks team as archived, deactivates its members and removes them from open reviews,
+// which nobody else could be given to. It returns updated team and affected reviews.
+func (u *Archiver) Archive(ctx context.Context, name string) (model.ArchivedTeam, error) {
+	ctx, span := telemetry.Start(ctx, "team.Archive", telemetry.Team(name))
+	defer span.End()
+
+	if len(name) == 0 {
+		return model.ArchivedTeam{}, model.ErrBadRequest
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageTeam(name) {
+		return model.ArchivedTeam{}, telemetry.Error(span, fmt.Errorf("archive team %s: %w", name, model.ErrForbidden))
+	}
+
+	res := model.ArchivedTeam{Team: model.Team{}, Affected: []model.ReviewChange{}}
+	err := u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		err := u.Team.Archive(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		members, err := u.User.GetByTeam(ctx, name)
+		if err != nil {
+			return err
+		}
+		err = u.User.DeactivateTeam(ctx, name)
+		if err != nil {
+			return err
+		}
+		// Archived team has no active members, so reviews can't be reassigned and are removed.
+		for _, m := range members {
+			changes, err := u.Reviews.Release(ctx, m, true)
+			if err != nil {
+				return err
+			}
+			res.Affected = append(res.Affected, changes...)
+		}
+
+		res.Team, err = getTeam(ctx, u.Team, u.User, name)
+		return err
+	})
+	if err != nil {
+		return model.ArchivedTeam{}, telemetry.Error(span, err)
+	}
+
+	slog.InfoContext(ctx, "Team archived",
+		slog.String("team_name", name),
+		slog.Int("members", len(res.Members)),
+		slog.Int("affected_pull_requests", len(res.Affected)),
+	)
+	return res, nil
+}
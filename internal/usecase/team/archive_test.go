@@ -0,0 +1,87 @@
+package team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (m *teamMockRepo) Archive(_ context.Context, name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func TestTeamArchive(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	releaser := new(reviewReleaserMock)
+	inactiveAlice := model.User{UserID: "u1", Username: "Alice", IsActive: false, TeamName: "team1"}
+	inactiveBob := model.User{UserID: "u2", Username: "Bob", IsActive: false, TeamName: "team1"}
+	removed := []model.ReviewChange{{PRID: "pr1", OldUserID: "u2", NewUserID: "", Action: model.ReviewRemoved}}
+
+	teamRepo.On("Archive", "team1").Return(nil)
+	userRepo.On("GetByTeam", "team1").Return([]model.User{alice, bob}, nil).Once()
+	userRepo.On("DeactivateTeam", "team1").Return(nil)
+	// Nobody in archived team can take reviews, so they are removed.
+	releaser.On("Release", alice, true).Return([]model.ReviewChange{}, nil)
+	releaser.On("Release", bob, true).Return(removed, nil)
+	teamRepo.On("Get", "team1").Return(archivedTeam, nil)
+	userRepo.On("GetByTeam", "team1").Return([]model.User{inactiveAlice, inactiveBob}, nil).Once()
+
+	u := team.Archiver{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+	res, err := u.Archive(t.Context(), "team1")
+	assert.NoError(t, err)
+	assert.Equal(t, model.ArchivedTeam{
+		Team:     model.Team{TeamName: "team1", Members: []model.User{inactiveAlice, inactiveBob}, Archived: true},
+		Affected: removed,
+	}, res)
+	teamRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+	releaser.AssertExpectations(t)
+}
+
+func TestTeamArchive_Errors(t *testing.T) {
+	type testCase struct {
+		testName     string
+		prepareMocks func(tR *teamMockRepo, uR *userMockRepo, r *reviewReleaserMock)
+		expectedErr  error
+	}
+
+	tests := []testCase{
+		{
+			testName: "Team not found",
+			prepareMocks: func(tR *teamMockRepo, _ *userMockRepo, _ *reviewReleaserMock) {
+				_ = tR.On("Archive", "team1").Return(model.ErrNotFound)
+			},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			testName: "Release error",
+			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo, r *reviewReleaserMock) {
+				_ = tR.On("Archive", "team1").Return(nil)
+				_ = uR.On("GetByTeam", "team1").Return([]model.User{alice}, nil)
+				_ = uR.On("DeactivateTeam", "team1").Return(nil)
+				_ = r.On("Release", mock.Anything, true).Return([]model.ReviewChange{}, errInternal)
+			},
+			expectedErr: errInternal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			teamRepo := new(teamMockRepo)
+			userRepo := new(userMockRepo)
+			releaser := new(reviewReleaserMock)
+			test.prepareMocks(teamRepo, userRepo, releaser)
+
+			u := team.Archiver{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+			res, err := u.Archive(t.Context(), "team1")
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, model.ArchivedTeam{}, res)
+		})
+	}
+}
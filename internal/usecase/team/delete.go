@@ -0,0 +1,100 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Deleter provides use case for deleting team.
+type Deleter struct {
+	TX   database.TransactionManager
+	Team teamDeleterRepo
+	User userDeleterRepo
+	PR   prCounterRepo
+}
+
+type teamDeleterRepo interface {
+	Get(ctx context.Context, name string) (model.Team, error)
+	Delete(ctx context.Context, name string) error
+}
+
+type userDeleterRepo interface {
+	GetByTeam(ctx context.Context, name string) ([]model.User, error)
+	DeactivateTeam(ctx context.Context, teamName string) error
+	MoveTeam(ctx context.Context, from, to string) error
+}
+
+type prCounterRepo interface {
+	CountOpenByTeam(ctx context.Context, teamName string) (int, error)
+}
+
+// Delete removes team and returns it as it was before deletion.
+// If target is given, members are moved to target team.
+// Otherwise deletion is refused while team has open pull requests, and members are deactivated.
+func (u *Deleter) Delete(ctx context.Context, name, target string) (model.Team, error) {
+	ctx, span := telemetry.Start(ctx, "team.Delete", telemetry.Team(name))
+	defer span.End()
+
+	if len(name) == 0 || name == target {
+		return model.Team{}, model.ErrBadRequest
+	}
+	actor, _ := model.ActorFrom(ctx)
+	if !actor.CanManageTeam(name) || (len(target) != 0 && !actor.CanManageTeam(target)) {
+		return model.Team{}, telemetry.Error(span, fmt.Errorf("delete team %s: %w", name, model.ErrForbidden))
+	}
+
+	var team model.Team
+	err := u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		team, err = getTeam(ctx, u.Team, u.User, name)
+		if err != nil {
+			return err
+		}
+
+		if len(target) != 0 {
+			err = u.moveMembers(ctx, name, target)
+		} else {
+			err = u.releaseMembers(ctx, name)
+		}
+		if err != nil {
+			return err
+		}
+
+		return u.Team.Delete(ctx, name)
+	})
+	if err != nil {
+		return model.Team{}, telemetry.Error(span, err)
+	}
+
+	slog.InfoContext(ctx, "Team deleted", slog.String("team_name", name), slog.String("target", target))
+	return team, nil
+}
+
+func (u *Deleter) moveMembers(ctx context.Context, name, target string) error {
+	team, err := u.Team.Get(ctx, target)
+	if err != nil {
+		return err
+	}
+	if team.Archived {
+		return fmt.Errorf("%s %w", target, model.ErrTeamArchived)
+	}
+
+	return u.User.MoveTeam(ctx, name, target)
+}
+
+func (u *Deleter) releaseMembers(ctx context.Context, name string) error {
+	open, err := u.PR.CountOpenByTeam(ctx, name)
+	if err != nil {
+		return err
+	}
+	if open > 0 {
+		return model.ErrTeamHasOpenPRs
+	}
+
+	return u.User.DeactivateTeam(ctx, name)
+}
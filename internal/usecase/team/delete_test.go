@@ -0,0 +1,136 @@
+package team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (m *teamMockRepo) Delete(_ context.Context, name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func (m *userMockRepo) DeactivateTeam(_ context.Context, teamName string) error {
+	args := m.Called(teamName)
+	return args.Error(0)
+}
+
+func (m *userMockRepo) MoveTeam(_ context.Context, from, to string) error {
+	args := m.Called(from, to)
+	return args.Error(0)
+}
+
+type prCounterMockRepo struct {
+	mock.Mock
+}
+
+func (m *prCounterMockRepo) CountOpenByTeam(_ context.Context, teamName string) (int, error) {
+	args := m.Called(teamName)
+	return args.Int(0), args.Error(1)
+}
+
+func TestTeamDelete_Validation(t *testing.T) {
+	var u team.Deleter
+
+	for _, args := range [][2]string{{"", ""}, {"", "team2"}, {"team1", "team1"}} {
+		team, err := u.Delete(t.Context(), args[0], args[1])
+		assert.ErrorIs(t, err, model.ErrBadRequest)
+		assert.Equal(t, noTeam, team)
+	}
+}
+
+type teamDeleteTestCase struct {
+	testName     string
+	prepareMocks func(tR *teamMockRepo, uR *userMockRepo, pR *prCounterMockRepo)
+	target       string
+	expected     model.Team
+	expectedErr  error
+}
+
+func TestTeamDelete(t *testing.T) {
+	emptyTeam := model.Team{TeamName: "team1", Members: []model.User{}, Archived: false}
+	tests := []teamDeleteTestCase{
+		{
+			testName: "No open pull requests",
+			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo, pR *prCounterMockRepo) {
+				_ = tR.On("Get", "team1").Return(emptyTeam, nil)
+				_ = uR.On("GetByTeam", "team1").Return(sampleTeam.Members, nil)
+				_ = pR.On("CountOpenByTeam", "team1").Return(0, nil)
+				_ = uR.On("DeactivateTeam", "team1").Return(nil)
+				_ = tR.On("Delete", "team1").Return(nil)
+			},
+			target:      "",
+			expected:    sampleTeam,
+			expectedErr: nil,
+		},
+		{
+			testName: "Open pull requests without target",
+			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo, pR *prCounterMockRepo) {
+				_ = tR.On("Get", "team1").Return(emptyTeam, nil)
+				_ = uR.On("GetByTeam", "team1").Return(sampleTeam.Members, nil)
+				_ = pR.On("CountOpenByTeam", "team1").Return(2, nil)
+			},
+			target:      "",
+			expected:    noTeam,
+			expectedErr: model.ErrTeamHasOpenPRs,
+		},
+		{
+			testName: "Members moved to target",
+			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo, _ *prCounterMockRepo) {
+				_ = tR.On("Get", "team1").Return(emptyTeam, nil)
+				_ = uR.On("GetByTeam", "team1").Return(sampleTeam.Members, nil)
+				_ = tR.On("Get", "team2").Return(
+					model.Team{TeamName: "team2", Members: []model.User{}, Archived: false}, nil)
+				_ = uR.On("MoveTeam", "team1", "team2").Return(nil)
+				_ = tR.On("Delete", "team1").Return(nil)
+			},
+			target:      "team2",
+			expected:    sampleTeam,
+			expectedErr: nil,
+		},
+		{
+			testName: "Target not found",
+			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo, _ *prCounterMockRepo) {
+				_ = tR.On("Get", "team1").Return(emptyTeam, nil)
+				_ = uR.On("GetByTeam", "team1").Return(sampleTeam.Members, nil)
+				_ = tR.On("Get", "team2").Return(noTeam, model.ErrNotFound)
+			},
+			target:      "team2",
+			expected:    noTeam,
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			testName: "Target archived",
+			prepareMocks: func(tR *teamMockRepo, uR *userMockRepo, _ *prCounterMockRepo) {
+				_ = tR.On("Get", "team1").Return(emptyTeam, nil)
+				_ = uR.On("GetByTeam", "team1").Return(sampleTeam.Members, nil)
+				_ = tR.On("Get", "team2").Return(
+					model.Team{TeamName: "team2", Members: []model.User{}, Archived: true}, nil)
+			},
+			target:      "team2",
+			expected:    noTeam,
+			expectedErr: model.ErrTeamArchived,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			teamRepo := new(teamMockRepo)
+			userRepo := new(userMockRepo)
+			prRepo := new(prCounterMockRepo)
+			test.prepareMocks(teamRepo, userRepo, prRepo)
+			u := team.Deleter{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, PR: prRepo}
+			team, err := u.Delete(t.Context(), "team1", test.target)
+			assert.Equal(t, test.expected, team)
+			assert.ErrorIs(t, err, test.expectedErr)
+			teamRepo.AssertExpectations(t)
+			userRepo.AssertExpectations(t)
+			prRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 )
 
 // Getter provides use case for getting team from repository.
@@ -22,16 +23,25 @@ type userGetterRepository interface {
 
 // Get queries repository for a team with given name.
 func (u *Getter) Get(ctx context.Context, name string) (model.Team, error) {
+	ctx, span := telemetry.Start(ctx, "team.Get", telemetry.Team(name))
+	defer span.End()
+
 	if len(name) == 0 {
 		return model.Team{}, model.ErrBadRequest
 	}
 
-	team, err := u.Team.Get(ctx, name)
+	return getTeam(ctx, u.Team, u.User, name)
+}
+
+func getTeam(
+	ctx context.Context, teamRepo teamGetterRepository, userRepo userGetterRepository, name string,
+) (model.Team, error) {
+	team, err := teamRepo.Get(ctx, name)
 	if err != nil {
 		return model.Team{}, err
 	}
 
-	team.Members, err = u.User.GetByTeam(ctx, name)
+	team.Members, err = userRepo.GetByTeam(ctx, name)
 	if err != nil {
 		return model.Team{}, err
 	}
@@ -0,0 +1,167 @@
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+type reviewReleaser interface {
+	Release(ctx context.Context, user model.User, reassign bool) ([]model.ReviewChange, error)
+}
+
+// MemberAdder provides use case for adding members to existing team.
+type MemberAdder struct {
+	TX   database.TransactionManager
+	Team teamGetterRepository
+	User userMemberAdderRepo
+}
+
+type userMemberAdderRepo interface {
+	Add(ctx context.Context, team model.Team) error
+	Get(ctx context.Context, id string) (model.User, error)
+	GetByTeam(ctx context.Context, name string) ([]model.User, error)
+}
+
+// AddMembers creates new users in team or updates its current members.
+// Members of other teams are rejected, they must be moved explicitly.
+func (u *MemberAdder) AddMembers(ctx context.Context, team model.Team) (model.Team, error) {
+	ctx, span := telemetry.Start(ctx, "team.AddMembers", telemetry.Team(team.TeamName))
+	defer span.End()
+
+	err := validate(team)
+	if err != nil {
+		return model.Team{}, telemetry.Error(span, err)
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageTeam(team.TeamName) {
+		return model.Team{}, telemetry.Error(span, fmt.Errorf("add members to %s: %w", team.TeamName, model.ErrForbidden))
+	}
+
+	var res model.Team
+	err = u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		current, err := u.Team.Get(ctx, team.TeamName)
+		if err != nil {
+			return err
+		}
+		if current.Archived {
+			return fmt.Errorf("%s %w", team.TeamName, model.ErrTeamArchived)
+		}
+
+		err = checkMembership(ctx, u.User, team)
+		if err != nil {
+			return err
+		}
+
+		err = u.User.Add(ctx, team)
+		if err != nil {
+			return err
+		}
+
+		res, err = getTeam(ctx, u.Team, u.User, team.TeamName)
+		return err
+	})
+	if err != nil {
+		return model.Team{}, telemetry.Error(span, err)
+	}
+
+	return res, nil
+}
+
+// MemberRemover provides use case for removing members from team.
+type MemberRemover struct {
+	TX      database.TransactionManager
+	Team    teamGetterRepository
+	User    userMemberRemoverRepo
+	Reviews reviewReleaser
+}
+
+type userMemberRemoverRepo interface {
+	Get(ctx context.Context, id string) (model.User, error)
+	RemoveFromTeam(ctx context.Context, uID string) error
+}
+
+// RemoveMembers leaves users without team and deactivates them.
+// Their open reviews are reassigned inside the team or kept according to reassign.
+func (u *MemberRemover) RemoveMembers(
+	ctx context.Context, teamName string, userIDs []string, reassign bool,
+) (model.MembershipChange, error) {
+	ctx, span := telemetry.Start(ctx, "team.RemoveMembers", telemetry.Team(teamName))
+	defer span.End()
+
+	if len(teamName) == 0 || len(userIDs) == 0 || hasEmpty(userIDs) {
+		return model.MembershipChange{}, model.ErrBadRequest
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageTeam(teamName) {
+		return model.MembershipChange{}, telemetry.Error(span,
+			fmt.Errorf("remove members from %s: %w", teamName, model.ErrForbidden))
+	}
+
+	var res model.MembershipChange
+	err := u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.Team.Get(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		removed := make([]model.User, 0, len(userIDs))
+		for _, id := range userIDs {
+			user, err := u.User.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			if user.TeamName != teamName {
+				return fmt.Errorf("%s in team %s %w", id, teamName, model.ErrNotFound)
+			}
+
+			err = u.User.RemoveFromTeam(ctx, id)
+			if err != nil {
+				return err
+			}
+			removed = append(removed, user)
+		}
+
+		res, err = u.release(ctx, teamName, removed, reassign)
+		return err
+	})
+	if err != nil {
+		return model.MembershipChange{}, telemetry.Error(span, err)
+	}
+
+	return res, nil
+}
+
+func (u *MemberRemover) release(
+	ctx context.Context, teamName string, removed []model.User, reassign bool,
+) (model.MembershipChange, error) {
+	res := model.MembershipChange{
+		TeamName: teamName,
+		Users:    make([]model.User, 0, len(removed)),
+		Affected: []model.ReviewChange{},
+	}
+	for _, user := range removed {
+		changes, err := u.Reviews.Release(ctx, user, reassign)
+		if err != nil {
+			return model.MembershipChange{}, err
+		}
+		res.Affected = append(res.Affected, changes...)
+
+		user, err = u.User.Get(ctx, user.UserID)
+		if err != nil {
+			return model.MembershipChange{}, err
+		}
+		res.Users = append(res.Users, user)
+	}
+	return res, nil
+}
+
+func hasEmpty(ids []string) bool {
+	for _, id := range ids {
+		if len(id) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+	"github.com/stretchr/testify/assert"
+)
+
+func (m *userMockRepo) Get(_ context.Context, id string) (model.User, error) {
+	args := m.Called(id)
+	return args.Get(0).(model.User), args.Error(1)
+}
+
+func (m *userMockRepo) RemoveFromTeam(_ context.Context, uID string) error {
+	args := m.Called(uID)
+	return args.Error(0)
+}
+
+//nolint:gochecknoglobals
+var (
+	activeTeam   = model.Team{TeamName: "team1", Members: nil, Archived: false}
+	archivedTeam = model.Team{TeamName: "team1", Members: nil, Archived: true}
+	alice        = model.User{UserID: "u1", Username: "Alice", IsActive: true, TeamName: "team1"}
+	bob          = model.User{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team1"}
+	noUser       model.User
+)
+
+func TestMemberAdd(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	teamRepo.On("Get", "team1").Return(activeTeam, nil)
+	// Current member is updated, unknown user is created.
+	userRepo.On("Get", "u1").Return(alice, nil)
+	userRepo.On("Get", "u2").Return(noUser, model.ErrNotFound)
+	userRepo.On("Add", sampleTeam).Return(nil)
+	userRepo.On("GetByTeam", "team1").Return([]model.User{alice, bob}, nil)
+
+	u := team.MemberAdder{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo}
+	res, err := u.AddMembers(t.Context(), sampleTeam)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Team{TeamName: "team1", Members: []model.User{alice, bob}, Archived: false}, res)
+	teamRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+func TestMemberAdd_UserInOtherTeam(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	teamRepo.On("Get", "team1").Return(activeTeam, nil)
+	userRepo.On("Get", "u1").Return(alice, nil)
+	userRepo.On("Get", "u2").Return(model.User{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team2"}, nil)
+
+	u := team.MemberAdder{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo}
+	res, err := u.AddMembers(t.Context(), sampleTeam)
+	assert.ErrorIs(t, err, model.ErrUserInOtherTeam)
+	assert.Equal(t, noTeam, res)
+	userRepo.AssertNotCalled(t, "Add", sampleTeam)
+}
+
+func TestMemberAdd_TeamArchived(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	teamRepo.On("Get", "team1").Return(archivedTeam, nil)
+
+	u := team.MemberAdder{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo}
+	res, err := u.AddMembers(t.Context(), sampleTeam)
+	assert.ErrorIs(t, err, model.ErrTeamArchived)
+	assert.Equal(t, noTeam, res)
+	userRepo.AssertExpectations(t)
+}
+
+func TestMemberRemove(t *testing.T) {
+	for _, reassign := range []bool{false, true} {
+		teamRepo := new(teamMockRepo)
+		userRepo := new(userMockRepo)
+		releaser := new(reviewReleaserMock)
+		removed := model.User{UserID: "u2", Username: "Bob", IsActive: false, TeamName: ""}
+		changes := []model.ReviewChange{{PRID: "pr1", OldUserID: "u2", NewUserID: "", Action: model.ReviewKept}}
+		if reassign {
+			changes[0].NewUserID = "u1"
+			changes[0].Action = model.ReviewReassigned
+		}
+		teamRepo.On("Get", "team1").Return(activeTeam, nil)
+		userRepo.On("Get", "u2").Return(bob, nil).Once()
+		userRepo.On("RemoveFromTeam", "u2").Return(nil)
+		// Reviews are released with team user had before removal.
+		releaser.On("Release", bob, reassign).Return(changes, nil)
+		userRepo.On("Get", "u2").Return(removed, nil).Once()
+
+		u := team.MemberRemover{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+		res, err := u.RemoveMembers(t.Context(), "team1", []string{"u2"}, reassign)
+		assert.NoError(t, err)
+		assert.Equal(t, model.MembershipChange{
+			TeamName: "team1", Users: []model.User{removed}, Affected: changes,
+		}, res)
+		teamRepo.AssertExpectations(t)
+		userRepo.AssertExpectations(t)
+		releaser.AssertExpectations(t)
+	}
+}
+
+func TestMemberRemove_NotMember(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	releaser := new(reviewReleaserMock)
+	teamRepo.On("Get", "team1").Return(activeTeam, nil)
+	userRepo.On("Get", "u2").Return(model.User{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team2"}, nil)
+
+	u := team.MemberRemover{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+	_, err := u.RemoveMembers(t.Context(), "team1", []string{"u2"}, true)
+	assert.ErrorIs(t, err, model.ErrNotFound)
+	userRepo.AssertNotCalled(t, "RemoveFromTeam", "u2")
+	releaser.AssertExpectations(t)
+}
@@ -0,0 +1,53 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Renamer provides use case for renaming team.
+type Renamer struct {
+	TX   database.TransactionManager
+	Team teamRenamerRepo
+	User userGetterRepository
+}
+
+type teamRenamerRepo interface {
+	Get(ctx context.Context, name string) (model.Team, error)
+	Rename(ctx context.Context, oldName, newName string) error
+}
+
+// Rename changes team name and returns renamed team with members.
+func (u *Renamer) Rename(ctx context.Context, oldName, newName string) (model.Team, error) {
+	ctx, span := telemetry.Start(ctx, "team.Rename", telemetry.Team(oldName))
+	defer span.End()
+
+	if len(oldName) == 0 || len(newName) == 0 || oldName == newName {
+		return model.Team{}, model.ErrBadRequest
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageTeam(oldName) {
+		return model.Team{}, telemetry.Error(span, fmt.Errorf("rename team %s: %w", oldName, model.ErrForbidden))
+	}
+
+	var team model.Team
+	err := u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		err := u.Team.Rename(ctx, oldName, newName)
+		if err != nil {
+			return err
+		}
+
+		team, err = getTeam(ctx, u.Team, u.User, newName)
+		return err
+	})
+	if err != nil {
+		return model.Team{}, telemetry.Error(span, err)
+	}
+
+	slog.InfoContext(ctx, "Team renamed", slog.String("old_name", oldName), slog.String("new_name", newName))
+	return team, nil
+}
@@ -0,0 +1,300 @@
+package team
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"gopkg.in/yaml.v3"
+)
+
+// Syncer provides use case for bringing teams and users to state described by roster.
+type Syncer struct {
+	TX      database.TransactionManager
+	Team    teamSyncRepo
+	User    userSyncRepo
+	Reviews reviewReleaser
+}
+
+type teamSyncRepo interface {
+	Add(ctx context.Context, team model.Team) (model.Team, error)
+	List(ctx context.Context) ([]model.Team, error)
+}
+
+type userSyncRepo interface {
+	Add(ctx context.Context, team model.Team) error
+	List(ctx context.Context) ([]model.User, error)
+	SetIsActive(ctx context.Context, uID string, isActive bool) error
+}
+
+// ParseRoster reads roster in YAML or JSON format.
+func ParseRoster(data []byte) (model.Roster, error) {
+	var roster model.Roster
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	err := decoder.Decode(&roster)
+	if err != nil {
+		return model.Roster{}, fmt.Errorf("%w: %w", model.ErrBadRequest, err)
+	}
+	return roster, nil
+}
+
+// Sync computes changes between database and roster and applies them in one transaction.
+// Users missing from roster are deactivated. Open reviews of deactivated users and users
+// moved to other team are reassigned inside their former team. Archived teams can't get
+// new or changed members. With dryRun only the plan is returned.
+func (u *Syncer) Sync(ctx context.Context, roster model.Roster, dryRun bool) (model.SyncPlan, error) {
+	ctx, span := telemetry.Start(ctx, "team.Sync", attribute.Bool("dry_run", dryRun))
+	defer span.End()
+
+	err := validateRoster(roster)
+	if err != nil {
+		return model.SyncPlan{}, telemetry.Error(span, err)
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageAll() {
+		return model.SyncPlan{}, telemetry.Error(span, fmt.Errorf("sync teams: %w", model.ErrForbidden))
+	}
+
+	var plan model.SyncPlan
+	err = u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		teams, err := u.Team.List(ctx)
+		if err != nil {
+			return err
+		}
+
+		users, err := u.User.List(ctx)
+		if err != nil {
+			return err
+		}
+
+		plan = model.SyncPlan{
+			DryRun:   dryRun,
+			Actions:  diff(teams, users, roster),
+			Affected: []model.ReviewChange{},
+		}
+		err = checkArchived(teams, plan.Actions)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			return nil
+		}
+
+		plan.Affected, err = u.apply(ctx, roster, plan.Actions, users)
+		return err
+	})
+	if err != nil {
+		return model.SyncPlan{}, telemetry.Error(span, err)
+	}
+
+	if !dryRun {
+		slog.InfoContext(ctx, "Roster applied",
+			slog.Int("actions", len(plan.Actions)),
+			slog.Int("affected_pull_requests", len(plan.Affected)),
+		)
+	}
+	return plan, nil
+}
+
+func (u *Syncer) apply(
+	ctx context.Context, roster model.Roster, actions []model.SyncAction, users []model.User,
+) ([]model.ReviewChange, error) {
+	current := make(map[string]model.User, len(users))
+	for _, user := range users {
+		current[user.UserID] = user
+	}
+	inactive := map[string]bool{}
+	for _, team := range roster.Teams {
+		for _, m := range team.Members {
+			inactive[m.UserID] = !m.IsActive
+		}
+	}
+
+	changed := map[string]bool{}
+	deactivated := []string{}
+	// released are users that leave their team or become inactive.
+	released := []string{}
+	for _, action := range actions {
+		switch action.Action {
+		case model.SyncCreateTeam:
+			_, err := u.Team.Add(ctx, model.Team{TeamName: action.TeamName, Members: nil, Archived: false})
+			if err != nil {
+				return nil, err
+			}
+		case model.SyncCreateUser:
+			changed[action.UserID] = true
+		case model.SyncUpdateUser:
+			changed[action.UserID] = true
+			if inactive[action.UserID] && current[action.UserID].IsActive {
+				released = append(released, action.UserID)
+			}
+		case model.SyncMoveUser:
+			changed[action.UserID] = true
+			if len(action.FromTeam) != 0 {
+				released = append(released, action.UserID)
+			}
+		case model.SyncDeactivateUser:
+			deactivated = append(deactivated, action.UserID)
+			released = append(released, action.UserID)
+		}
+	}
+
+	for _, team := range roster.Teams {
+		team.Members = filterMembers(team.Members, changed)
+		if len(team.Members) == 0 {
+			continue
+		}
+		err := u.User.Add(ctx, team)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range deactivated {
+		err := u.User.SetIsActive(ctx, id, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return u.releaseReviews(ctx, current, released)
+}
+
+// releaseReviews reassigns open reviews of users inside team they had before sync.
+func (u *Syncer) releaseReviews(
+	ctx context.Context, current map[string]model.User, released []string,
+) ([]model.ReviewChange, error) {
+	affected := []model.ReviewChange{}
+	for _, id := range released {
+		changes, err := u.Reviews.Release(ctx, current[id], true)
+		if err != nil {
+			return nil, err
+		}
+		affected = append(affected, changes...)
+	}
+	return affected, nil
+}
+
+// diff lists actions in roster order, followed by deactivations in order of users.
+func diff(teams []model.Team, users []model.User, roster model.Roster) []model.SyncAction {
+	existing := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		existing[team.TeamName] = true
+	}
+	current := make(map[string]model.User, len(users))
+	for _, user := range users {
+		current[user.UserID] = user
+	}
+
+	actions := []model.SyncAction{}
+	listed := map[string]bool{}
+	for _, team := range roster.Teams {
+		if !existing[team.TeamName] {
+			actions = append(actions, model.SyncAction{
+				Action: model.SyncCreateTeam, TeamName: team.TeamName, UserID: "", Username: "", FromTeam: "",
+			})
+		}
+		for _, m := range team.Members {
+			listed[m.UserID] = true
+			action, ok := memberAction(current, m, team.TeamName)
+			if ok {
+				actions = append(actions, action)
+			}
+		}
+	}
+
+	for _, user := range users {
+		if !listed[user.UserID] && user.IsActive {
+			actions = append(actions, model.SyncAction{
+				Action:   model.SyncDeactivateUser,
+				TeamName: user.TeamName,
+				UserID:   user.UserID,
+				Username: user.Username,
+				FromTeam: "",
+			})
+		}
+	}
+	return actions
+}
+
+func memberAction(
+	current map[string]model.User, member model.User, teamName string,
+) (model.SyncAction, bool) {
+	action := model.SyncAction{
+		Action:   "",
+		TeamName: teamName,
+		UserID:   member.UserID,
+		Username: member.Username,
+		FromTeam: "",
+	}
+
+	user, ok := current[member.UserID]
+	switch {
+	case !ok:
+		action.Action = model.SyncCreateUser
+	case user.TeamName != teamName:
+		action.Action = model.SyncMoveUser
+		action.FromTeam = user.TeamName
+	case user.Username != member.Username || user.IsActive != member.IsActive:
+		action.Action = model.SyncUpdateUser
+	default:
+		return model.SyncAction{}, false
+	}
+	return action, true
+}
+
+// checkArchived rejects actions that create, update or move users into archived team.
+func checkArchived(teams []model.Team, actions []model.SyncAction) error {
+	archived := map[string]bool{}
+	for _, team := range teams {
+		archived[team.TeamName] = team.Archived
+	}
+	for _, action := range actions {
+		if action.Action != model.SyncDeactivateUser && len(action.UserID) != 0 && archived[action.TeamName] {
+			return fmt.Errorf("%s of %s %w", action.UserID, action.TeamName, model.ErrTeamArchived)
+		}
+	}
+	return nil
+}
+
+func filterMembers(members []model.User, keep map[string]bool) []model.User {
+	res := make([]model.User, 0, len(members))
+	for _, m := range members {
+		if keep[m.UserID] {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+func validateRoster(roster model.Roster) error {
+	if len(roster.Teams) == 0 {
+		return model.ErrBadRequest
+	}
+
+	teams := map[string]bool{}
+	users := map[string]bool{}
+	for _, team := range roster.Teams {
+		err := validate(team)
+		if err != nil {
+			return err
+		}
+		if teams[team.TeamName] {
+			return fmt.Errorf("duplicate team %s: %w", team.TeamName, model.ErrBadRequest)
+		}
+		teams[team.TeamName] = true
+
+		for _, m := range team.Members {
+			if users[m.UserID] {
+				return fmt.Errorf("duplicate user %s: %w", m.UserID, model.ErrBadRequest)
+			}
+			users[m.UserID] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (m *teamMockRepo) List(_ context.Context) ([]model.Team, error) {
+	args := m.Called()
+	return args.Get(0).([]model.Team), args.Error(1)
+}
+
+func (m *userMockRepo) List(_ context.Context) ([]model.User, error) {
+	args := m.Called()
+	return args.Get(0).([]model.User), args.Error(1)
+}
+
+func (m *userMockRepo) SetIsActive(_ context.Context, uID string, isActive bool) error {
+	args := m.Called(uID, isActive)
+	return args.Error(0)
+}
+
+type reviewReleaserMock struct {
+	mock.Mock
+}
+
+func (m *reviewReleaserMock) Release(
+	_ context.Context, user model.User, reassign bool,
+) ([]model.ReviewChange, error) {
+	args := m.Called(user, reassign)
+	return args.Get(0).([]model.ReviewChange), args.Error(1)
+}
+
+const rosterYAML = `
+teams:
+  - team_name: team1
+    members:
+      - user_id: u1
+        username: Alice
+        is_active: true
+      - user_id: u2
+        username: Robert
+        is_active: true
+      - user_id: u4
+        username: Dave
+        is_active: true
+  - team_name: team2
+    members:
+      - user_id: u5
+        username: Eve
+        is_active: true
+`
+
+//nolint:gochecknoglobals
+var currentUsers = []model.User{
+	{UserID: "u1", Username: "Alice", IsActive: true, TeamName: "team1"},
+	{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team1"},
+	{UserID: "u3", Username: "Carol", IsActive: true, TeamName: "team1"},
+	{UserID: "u4", Username: "Dave", IsActive: true, TeamName: "team3"},
+}
+
+//nolint:exhaustruct
+func expectedActions() []model.SyncAction {
+	return []model.SyncAction{
+		{Action: model.SyncUpdateUser, TeamName: "team1", UserID: "u2", Username: "Robert"},
+		{Action: model.SyncMoveUser, TeamName: "team1", UserID: "u4", Username: "Dave", FromTeam: "team3"},
+		{Action: model.SyncCreateTeam, TeamName: "team2"},
+		{Action: model.SyncCreateUser, TeamName: "team2", UserID: "u5", Username: "Eve"},
+		{Action: model.SyncDeactivateUser, TeamName: "team1", UserID: "u3", Username: "Carol"},
+	}
+}
+
+func prepareSyncMocks(tR *teamMockRepo, uR *userMockRepo) {
+	tR.On("List").Return([]model.Team{
+		{TeamName: "team1", Members: []model.User{}, Archived: false},
+		{TeamName: "team3", Members: []model.User{}, Archived: false},
+	}, nil)
+	uR.On("List").Return(currentUsers, nil)
+}
+
+func TestTeamSync_DryRun(t *testing.T) {
+	roster, err := team.ParseRoster([]byte(rosterYAML))
+	assert.NoError(t, err)
+
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	prepareSyncMocks(teamRepo, userRepo)
+
+	u := team.Syncer{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: nil}
+	plan, err := u.Sync(t.Context(), roster, true)
+	assert.NoError(t, err)
+	assert.Equal(t, model.SyncPlan{
+		DryRun:   true,
+		Actions:  expectedActions(),
+		Affected: []model.ReviewChange{},
+	}, plan)
+	teamRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+//nolint:exhaustruct
+func TestTeamSync_Apply(t *testing.T) {
+	roster, err := team.ParseRoster([]byte(rosterYAML))
+	assert.NoError(t, err)
+
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	releaser := new(reviewReleaserMock)
+	prepareSyncMocks(teamRepo, userRepo)
+	moved := []model.ReviewChange{
+		{PRID: "pr2", OldUserID: "u4", NewUserID: "", Action: model.ReviewRemoved},
+	}
+	reassigned := []model.ReviewChange{
+		{PRID: "pr1", OldUserID: "u3", NewUserID: "u1", Action: model.ReviewReassigned},
+	}
+	teamRepo.On("Add", model.Team{TeamName: "team2"}).Return(model.Team{TeamName: "team2"}, nil)
+	userRepo.On("Add", model.Team{TeamName: "team1", Members: []model.User{
+		{UserID: "u2", Username: "Robert", IsActive: true},
+		{UserID: "u4", Username: "Dave", IsActive: true},
+	}}).Return(nil)
+	userRepo.On("Add", model.Team{TeamName: "team2", Members: []model.User{
+		{UserID: "u5", Username: "Eve", IsActive: true},
+	}}).Return(nil)
+	userRepo.On("SetIsActive", "u3", false).Return(nil)
+	// Moved user gives reviews to former team like deactivated one.
+	releaser.On("Release", currentUsers[3], true).Return(moved, nil)
+	releaser.On("Release", currentUsers[2], true).Return(reassigned, nil)
+
+	u := team.Syncer{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+	plan, err := u.Sync(t.Context(), roster, false)
+	assert.NoError(t, err)
+	assert.Equal(t, model.SyncPlan{
+		DryRun: false, Actions: expectedActions(), Affected: append(moved, reassigned...),
+	}, plan)
+	teamRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+	releaser.AssertExpectations(t)
+}
+
+//nolint:exhaustruct
+func TestTeamSync_InactiveMember(t *testing.T) {
+	roster, err := team.ParseRoster([]byte(`teams: [{team_name: team1, members: [` +
+		`{user_id: u1, username: Alice, is_active: false}, {user_id: u2, username: Robert, is_active: false}]}]`))
+	assert.NoError(t, err)
+
+	alice := model.User{UserID: "u1", Username: "Alice", IsActive: true, TeamName: "team1"}
+	bob := model.User{UserID: "u2", Username: "Bob", IsActive: false, TeamName: "team1"}
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	releaser := new(reviewReleaserMock)
+	teamRepo.On("List").Return([]model.Team{{TeamName: "team1"}}, nil)
+	userRepo.On("List").Return([]model.User{alice, bob}, nil)
+	userRepo.On("Add", mock.Anything).Return(nil)
+	// Only user that was active loses reviews.
+	releaser.On("Release", alice, true).Return([]model.ReviewChange{}, nil).Once()
+
+	u := team.Syncer{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+	_, err = u.Sync(t.Context(), roster, false)
+	assert.NoError(t, err)
+	releaser.AssertExpectations(t)
+}
+
+//nolint:exhaustruct
+func TestTeamSync_ArchivedTeam(t *testing.T) {
+	roster, err := team.ParseRoster([]byte(`teams: [{team_name: frozen, members: [` +
+		`{user_id: u1, username: Alice, is_active: true}, {user_id: u2, username: Bob, is_active: true}]}]`))
+	assert.NoError(t, err)
+
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	teamRepo.On("List").Return([]model.Team{{TeamName: "frozen", Archived: true}}, nil)
+	userRepo.On("List").Return([]model.User{
+		{UserID: "u1", Username: "Alice", IsActive: true, TeamName: "frozen"},
+	}, nil)
+
+	u := team.Syncer{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: nil}
+	for _, dryRun := range []bool{true, false} {
+		_, err = u.Sync(t.Context(), roster, dryRun)
+		assert.ErrorIs(t, err, model.ErrTeamArchived)
+	}
+	userRepo.AssertNotCalled(t, "Add", mock.Anything)
+}
+
+func TestTeamSync_Validation(t *testing.T) {
+	for _, roster := range []string{
+		`teams: []`,
+		`teams: [{team_name: t1, members: [{user_id: u1, username: A}]}, {team_name: t1, members: [{user_id: u2, username: B}]}]`,
+		`teams: [{team_name: t1, members: [{user_id: u1, username: A}]}, {team_name: t2, members: [{user_id: u1, username: B}]}]`,
+		`teams: [{team_name: t1, members: [{user_id: u1, name: A}]}]`,
+	} {
+		t.Run(roster, func(t *testing.T) {
+			var u team.Syncer
+			parsed, err := team.ParseRoster([]byte(roster))
+			if err == nil {
+				_, err = u.Sync(t.Context(), parsed, true)
+			}
+			assert.ErrorIs(t, err, model.ErrBadRequest)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package transfer
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// Exporter provides use case for exporting users and pull requests to CSV.
+type Exporter struct {
+	User userExportRepo
+	PR   prExportRepo
+}
+
+type userExportRepo interface {
+	ForEach(ctx context.Context, fn func(model.User) error) error
+}
+
+type prExportRepo interface {
+	ForEach(ctx context.Context, fn func(model.PullRequest) error) error
+}
+
+// prColumns is header of pull requests CSV.
+//
+//nolint:gochecknoglobals
+var prColumns = []string{
+	"pull_request_id", "pull_request_name", "author_id", "status",
+	"assigned_reviewers", "created_at", "merged_at",
+}
+
+// ExportUsers writes all users as CSV in the format accepted by ImportUsers.
+func (u *Exporter) ExportUsers(ctx context.Context, w io.Writer) error {
+	ctx, span := telemetry.Start(ctx, "transfer.ExportUsers")
+	defer span.End()
+
+	writer := csv.NewWriter(w)
+	err := writer.Write(userColumns)
+	if err != nil {
+		return telemetry.Error(span, err)
+	}
+
+	err = u.User.ForEach(ctx, func(user model.User) error {
+		return writer.Write([]string{
+			user.UserID, user.Username, user.TeamName, strconv.FormatBool(user.IsActive),
+		})
+	})
+	if err != nil {
+		return telemetry.Error(span, err)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ExportPullRequests writes all pull requests as CSV, reviewers are separated by spaces.
+func (u *Exporter) ExportPullRequests(ctx context.Context, w io.Writer) error {
+	ctx, span := telemetry.Start(ctx, "transfer.ExportPullRequests")
+	defer span.End()
+
+	writer := csv.NewWriter(w)
+	err := writer.Write(prColumns)
+	if err != nil {
+		return telemetry.Error(span, err)
+	}
+
+	err = u.PR.ForEach(ctx, func(pr model.PullRequest) error {
+		return writer.Write([]string{
+			pr.ID, pr.Name, pr.AuthorID, pr.Status,
+			strings.Join(pr.Reviewers, " "), formatTime(pr.CreatedAt), formatTime(pr.MergedAt),
+		})
+	})
+	if err != nil {
+		return telemetry.Error(span, err)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
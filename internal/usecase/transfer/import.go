@@ -0,0 +1,229 @@
+// Package transfer provides use cases for importing and exporting data in CSV.
+package transfer
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+	"github.com/LeonovDS/review-manager/internal/usecase/team"
+)
+
+// Importer provides use case for importing users from CSV.
+type Importer struct {
+	TX      database.TransactionManager
+	Team    teamImportRepo
+	User    userImportRepo
+	Reviews reviewReleaser
+}
+
+type teamImportRepo interface {
+	Add(ctx context.Context, team model.Team) (model.Team, error)
+	List(ctx context.Context) ([]model.Team, error)
+}
+
+type userImportRepo interface {
+	Add(ctx context.Context, team model.Team) error
+	Get(ctx context.Context, id string) (model.User, error)
+}
+
+type reviewReleaser interface {
+	Release(ctx context.Context, user model.User, reassign bool) ([]model.ReviewChange, error)
+}
+
+// userColumns is header of users CSV.
+//
+//nolint:gochecknoglobals
+var userColumns = []string{"user_id", "username", "team_name", "is_active"}
+
+// ImportUsers reads users CSV with header, creates missing teams and creates or updates users.
+// Like adding members to team, users of other teams and members of archived teams are rejected,
+// and open reviews of users that become inactive are reassigned inside their team.
+// Invalid rows are reported all at once, and nothing is imported.
+func (u *Importer) ImportUsers(ctx context.Context, r io.Reader) (model.ImportResult, error) {
+	ctx, span := telemetry.Start(ctx, "transfer.ImportUsers")
+	defer span.End()
+
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageAll() {
+		return model.ImportResult{}, telemetry.Error(span, fmt.Errorf("import users: %w", model.ErrForbidden))
+	}
+
+	teams, rows, rowErrors, err := parseUsers(r)
+	if err != nil {
+		return model.ImportResult{}, telemetry.Error(span, err)
+	}
+	if len(rowErrors) != 0 {
+		return model.ImportResult{Imported: 0, CreatedTeams: []string{}, Errors: rowErrors}, nil
+	}
+
+	res := model.ImportResult{Imported: 0, CreatedTeams: []string{}, Errors: nil}
+	err = u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		existing, err := u.Team.List(ctx)
+		if err != nil {
+			return err
+		}
+		deactivated, rowErrors, err := u.checkMembers(ctx, teams, existing, rows)
+		if err != nil || len(rowErrors) != 0 {
+			res.Errors = rowErrors
+			return err
+		}
+
+		for _, t := range teams {
+			if !slices.ContainsFunc(existing, func(e model.Team) bool { return e.TeamName == t.TeamName }) {
+				_, err = u.Team.Add(ctx, t)
+				if err != nil {
+					return err
+				}
+				res.CreatedTeams = append(res.CreatedTeams, t.TeamName)
+			}
+
+			err = u.User.Add(ctx, t)
+			if err != nil {
+				return err
+			}
+			res.Imported += len(t.Members)
+		}
+
+		// Reviews are released after import, so only users active after it are candidates.
+		for _, user := range deactivated {
+			_, err = u.Reviews.Release(ctx, user, true)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return model.ImportResult{}, telemetry.Error(span, err)
+	}
+	if len(res.Errors) != 0 {
+		return model.ImportResult{Imported: 0, CreatedTeams: []string{}, Errors: res.Errors}, nil
+	}
+
+	return res, nil
+}
+
+// checkMembers reports rows that would move existing users between teams or into archived team.
+// It returns current state of users that become inactive.
+func (u *Importer) checkMembers(
+	ctx context.Context, teams, existing []model.Team, rows map[string]int,
+) ([]model.User, []model.RowError, error) {
+	var deactivated []model.User
+	var rowErrors []model.RowError
+	for _, t := range teams {
+		archived := slices.ContainsFunc(existing, func(e model.Team) bool {
+			return e.TeamName == t.TeamName && e.Archived
+		})
+		for _, m := range t.Members {
+			if archived {
+				rowErrors = append(rowErrors, model.NewRowError(rows[m.UserID],
+					fmt.Errorf("%s %w", t.TeamName, model.ErrTeamArchived)))
+				continue
+			}
+
+			current, err := u.User.Get(ctx, m.UserID)
+			if errors.Is(err, model.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return nil, nil, err
+			}
+			switch {
+			case current.TeamName != "" && current.TeamName != t.TeamName:
+				rowErrors = append(rowErrors, model.NewRowError(rows[m.UserID],
+					fmt.Errorf("%s %w %s", m.UserID, model.ErrUserInOtherTeam, current.TeamName)))
+			case current.IsActive && !m.IsActive:
+				deactivated = append(deactivated, current)
+			}
+		}
+	}
+	slices.SortFunc(rowErrors, func(a, b model.RowError) int { return a.Row - b.Row })
+	return deactivated, rowErrors, nil
+}
+
+// parseUsers groups valid rows by team in order of first appearance and returns row of every user.
+// Malformed CSV is reported as error, invalid values as row errors.
+func parseUsers(r io.Reader) ([]model.Team, map[string]int, []model.RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(userColumns)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, nil, fmt.Errorf("empty file: %w", model.ErrBadRequest)
+	} else if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %w", model.ErrBadRequest, err)
+	}
+	if !slices.Equal(header, userColumns) {
+		return nil, nil, nil, fmt.Errorf(
+			"header must be %s: %w", strings.Join(userColumns, ","), model.ErrBadRequest)
+	}
+
+	var teams []model.Team
+	var rowErrors []model.RowError
+	seen := map[string]int{}
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, nil, nil, fmt.Errorf("%w: %w", model.ErrBadRequest, err)
+		}
+
+		user, err := parseUser(record)
+		if err == nil && seen[user.UserID] != 0 {
+			err = fmt.Errorf("user_id %s is duplicate of row %d: %w",
+				user.UserID, seen[user.UserID], model.ErrBadRequest)
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, model.NewRowError(row, err))
+			continue
+		}
+		seen[user.UserID] = row
+		teams = addToTeam(teams, user)
+	}
+
+	if len(teams) == 0 && len(rowErrors) == 0 {
+		return nil, nil, nil, fmt.Errorf("no users: %w", model.ErrBadRequest)
+	}
+	return teams, seen, rowErrors, nil
+}
+
+func parseUser(record []string) (model.User, error) {
+	isActive, err := strconv.ParseBool(record[3])
+	if err != nil {
+		return model.User{}, fmt.Errorf("is_active must be true or false: %w", model.ErrBadRequest)
+	}
+
+	user := model.User{
+		UserID:   record[0],
+		Username: record[1],
+		IsActive: isActive,
+		TeamName: record[2],
+	}
+	if len(user.TeamName) == 0 {
+		return model.User{}, fmt.Errorf("team_name is empty: %w", model.ErrBadRequest)
+	}
+	err = team.ValidateMember(user)
+	if err != nil {
+		return model.User{}, err
+	}
+	return user, nil
+}
+
+func addToTeam(teams []model.Team, user model.User) []model.Team {
+	i := slices.IndexFunc(teams, func(t model.Team) bool { return t.TeamName == user.TeamName })
+	if i < 0 {
+		teams = append(teams, model.Team{TeamName: user.TeamName, Members: nil, Archived: false})
+		i = len(teams) - 1
+	}
+	teams[i].Members = append(teams[i].Members, user)
+	return teams
+}
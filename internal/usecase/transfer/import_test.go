@@ -0,0 +1,177 @@
+package transfer_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/transfer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type teamMockRepo struct {
+	mock.Mock
+}
+
+func (m *teamMockRepo) Add(_ context.Context, team model.Team) (model.Team, error) {
+	args := m.Called(team)
+	return args.Get(0).(model.Team), args.Error(1)
+}
+
+func (m *teamMockRepo) List(_ context.Context) ([]model.Team, error) {
+	args := m.Called()
+	return args.Get(0).([]model.Team), args.Error(1)
+}
+
+type userMockRepo struct {
+	mock.Mock
+}
+
+func (m *userMockRepo) Add(_ context.Context, team model.Team) error {
+	args := m.Called(team)
+	return args.Error(0)
+}
+
+func (m *userMockRepo) Get(_ context.Context, id string) (model.User, error) {
+	args := m.Called(id)
+	return args.Get(0).(model.User), args.Error(1)
+}
+
+type reviewsMock struct {
+	mock.Mock
+}
+
+func (m *reviewsMock) Release(_ context.Context, user model.User, reassign bool) ([]model.ReviewChange, error) {
+	args := m.Called(user, reassign)
+	return args.Get(0).([]model.ReviewChange), args.Error(1)
+}
+
+type fakeTransactionManager struct{}
+
+func (tm *fakeTransactionManager) WithTransaction(
+	ctx context.Context, transaction func(context.Context) error,
+) error {
+	return transaction(ctx)
+}
+
+//nolint:exhaustruct
+func TestImportUsers(t *testing.T) {
+	csv := "user_id,username,team_name,is_active\n" +
+		"u1,Alice,team1,true\n" +
+		"u2,Bob,team2,false\n" +
+		"u3,Carol,team1,true\n"
+	team1 := model.Team{TeamName: "team1", Members: []model.User{
+		{UserID: "u1", Username: "Alice", IsActive: true, TeamName: "team1"},
+		{UserID: "u3", Username: "Carol", IsActive: true, TeamName: "team1"},
+	}}
+	team2 := model.Team{TeamName: "team2", Members: []model.User{
+		{UserID: "u2", Username: "Bob", IsActive: false, TeamName: "team2"},
+	}}
+
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	teamRepo.On("List").Return([]model.Team{{TeamName: "team1"}}, nil)
+	teamRepo.On("Add", team2).Return(team2, nil)
+	userRepo.On("Add", team1).Return(nil)
+	userRepo.On("Add", team2).Return(nil)
+	userRepo.On("Get", "u1").Return(model.User{UserID: "u1", IsActive: true, TeamName: "team1"}, nil)
+	userRepo.On("Get", mock.Anything).Return(model.User{}, model.ErrNotFound)
+
+	u := transfer.Importer{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: new(reviewsMock)}
+	res, err := u.ImportUsers(t.Context(), strings.NewReader(csv))
+	assert.NoError(t, err)
+	assert.Equal(t, model.ImportResult{Imported: 3, CreatedTeams: []string{"team2"}, Errors: nil}, res)
+	teamRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+//nolint:exhaustruct
+func TestImportUsers_Conflicts(t *testing.T) {
+	csv := "user_id,username,team_name,is_active\n" +
+		"u1,Alice,team1,true\n" +
+		"u2,Bob,frozen,true\n" +
+		"u3,Carol,team1,true\n"
+
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	teamRepo.On("List").Return([]model.Team{{TeamName: "team1"}, {TeamName: "frozen", Archived: true}}, nil)
+	userRepo.On("Get", "u1").Return(model.User{UserID: "u1", IsActive: true, TeamName: "team2"}, nil)
+	userRepo.On("Get", "u3").Return(model.User{}, model.ErrNotFound)
+
+	u := transfer.Importer{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: new(reviewsMock)}
+	res, err := u.ImportUsers(t.Context(), strings.NewReader(csv))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, res.Imported)
+	assert.Empty(t, res.CreatedTeams)
+	// Nothing is written when any user can't be imported.
+	userRepo.AssertNotCalled(t, "Add", mock.Anything)
+	if assert.Len(t, res.Errors, 2) {
+		assert.Equal(t, 2, res.Errors[0].Row)
+		assert.ErrorIs(t, res.Errors[0].Err, model.ErrUserInOtherTeam)
+		assert.Equal(t, 3, res.Errors[1].Row)
+		assert.ErrorIs(t, res.Errors[1].Err, model.ErrTeamArchived)
+	}
+}
+
+//nolint:exhaustruct
+func TestImportUsers_ReleasesDeactivated(t *testing.T) {
+	csv := "user_id,username,team_name,is_active\n" +
+		"u1,Alice,team1,false\n" +
+		"u2,Bob,team1,false\n" +
+		"u3,Carol,team1,true\n"
+	alice := model.User{UserID: "u1", Username: "Alice", IsActive: true, TeamName: "team1"}
+	bob := model.User{UserID: "u2", Username: "Bob", IsActive: false, TeamName: "team1"}
+	carol := model.User{UserID: "u3", Username: "Carol", IsActive: true, TeamName: ""}
+
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	reviews := new(reviewsMock)
+	teamRepo.On("List").Return([]model.Team{{TeamName: "team1"}}, nil)
+	userRepo.On("Get", "u1").Return(alice, nil)
+	userRepo.On("Get", "u2").Return(bob, nil)
+	userRepo.On("Get", "u3").Return(carol, nil)
+	userRepo.On("Add", mock.Anything).Return(nil)
+	reviews.On("Release", alice, true).Return([]model.ReviewChange{}, nil)
+
+	u := transfer.Importer{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: reviews}
+	res, err := u.ImportUsers(t.Context(), strings.NewReader(csv))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, res.Imported)
+	// Only active user that becomes inactive gives away reviews, user without team may join.
+	reviews.AssertExpectations(t)
+	reviews.AssertNumberOfCalls(t, "Release", 1)
+}
+
+func TestImportUsers_RowErrors(t *testing.T) {
+	csv := "user_id,username,team_name,is_active\n" +
+		"u1,Alice,team1,true\n" +
+		",Bob,team1,true\n" +
+		"u3,Carol,,true\n" +
+		"u4,Dave,team1,maybe\n" +
+		"u1,Alice,team2,true\n"
+
+	u := transfer.Importer{TX: &fakeTransactionManager{}, Team: new(teamMockRepo), User: new(userMockRepo)}
+	res, err := u.ImportUsers(t.Context(), strings.NewReader(csv))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, res.Imported)
+	rows := make([]int, 0, len(res.Errors))
+	for _, e := range res.Errors {
+		rows = append(rows, e.Row)
+	}
+	assert.Equal(t, []int{3, 4, 5, 6}, rows)
+}
+
+func TestImportUsers_Malformed(t *testing.T) {
+	for _, csv := range []string{
+		"",
+		"id,name,team,active\nu1,Alice,team1,true\n",
+		"user_id,username,team_name,is_active\nu1,Alice\n",
+		"user_id,username,team_name,is_active\n",
+	} {
+		var u transfer.Importer
+		_, err := u.ImportUsers(t.Context(), strings.NewReader(csv))
+		assert.ErrorIs(t, err, model.ErrBadRequest)
+	}
+}
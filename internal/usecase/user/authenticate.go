@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+)
+
+// Authenticator provides use case for identifying engineer by bearer JWT.
+type Authenticator struct {
+	Tokens tokenVerifier
+	User   userGetterRepo
+}
+
+type tokenVerifier interface {
+	Verify(token string) (model.Identity, error)
+}
+
+type userGetterRepo interface {
+	Get(ctx context.Context, id string) (model.User, error)
+}
+
+// Authenticate returns actor of engineer that token was issued for.
+// Engineer must be known to service and active, so actions can be limited to own team and reviews.
+// Engineer is looked up in organization named by token, tokens without it belong to model.DefaultOrg.
+func (u *Authenticator) Authenticate(ctx context.Context, token string) (model.Actor, error) {
+	identity, err := u.Tokens.Verify(token)
+	if err != nil {
+		return model.Actor{}, err
+	}
+	ctx = model.WithOrg(ctx, identity.Org)
+
+	user, err := u.User.Get(ctx, identity.UserID)
+	if errors.Is(err, model.ErrNotFound) {
+		return model.Actor{}, fmt.Errorf("user %s is unknown: %w", identity.UserID, model.ErrUnauthorized)
+	}
+	if err != nil {
+		return model.Actor{}, err
+	}
+	// Deactivated and removed engineers keep valid tokens until they expire, but lose access at once.
+	if !user.IsActive {
+		return model.Actor{}, fmt.Errorf("user %s is inactive: %w", identity.UserID, model.ErrUnauthorized)
+	}
+
+	role := identity.Role()
+	return model.Actor{
+		ID:     user.UserID,
+		Name:   user.Username,
+		Org:    model.OrgFrom(ctx),
+		UserID: user.UserID,
+		Role:   role,
+		Team:   user.TeamName,
+		Scopes: role.Scopes(),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package user_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/user"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeVerifier accepts tokens equal to user id.
+type fakeVerifier struct{}
+
+func (fakeVerifier) Verify(token string) (model.Identity, error) {
+	if len(token) == 0 {
+		return model.Identity{}, fmt.Errorf("empty token: %w", model.ErrUnauthorized)
+	}
+	return model.Identity{Org: "", UserID: token, Roles: nil}, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	repo := new(userMockRepo)
+	repo.On("Get", "u2").Return(bob, nil)
+
+	u := user.Authenticator{Tokens: fakeVerifier{}, User: repo}
+	actor, err := u.Authenticate(t.Context(), "u2")
+	assert.NoError(t, err)
+	assert.Equal(t, model.Actor{
+		ID:     "u2",
+		Name:   "Bob",
+		Org:    model.DefaultOrg,
+		UserID: "u2",
+		Role:   model.RoleMember,
+		Team:   "team1",
+		Scopes: []model.Scope{model.ScopeRead, model.ScopeWritePR},
+	}, actor)
+}
+
+func TestAuthenticate_Rejected(t *testing.T) {
+	tests := []struct {
+		name string
+		user model.User
+		err  error
+	}{
+		{
+			name: "Unknown user",
+			user: model.User{},
+			err:  model.ErrNotFound,
+		},
+		{
+			name: "Inactive user",
+			user: model.User{UserID: "u2", Username: "Bob", IsActive: false, TeamName: "team1"},
+			err:  nil,
+		},
+		{
+			name: "Removed from team",
+			user: model.User{UserID: "u2", Username: "Bob", IsActive: false, TeamName: ""},
+			err:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := new(userMockRepo)
+			repo.On("Get", "u2").Return(test.user, test.err)
+
+			u := user.Authenticator{Tokens: fakeVerifier{}, User: repo}
+			actor, err := u.Authenticate(t.Context(), "u2")
+			assert.ErrorIs(t, err, model.ErrUnauthorized)
+			assert.Equal(t, model.Actor{}, actor)
+		})
+	}
+
+	// Invalid token is rejected before user is looked up.
+	u := user.Authenticator{Tokens: fakeVerifier{}, User: new(userMockRepo)}
+	_, err := u.Authenticate(t.Context(), "")
+	assert.ErrorIs(t, err, model.ErrUnauthorized)
+}
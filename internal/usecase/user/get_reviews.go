@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 )
 
 // ReviewGetter provides use case for forming report about user's reviews.
@@ -13,22 +14,94 @@ type ReviewGetter struct {
 }
 
 type reviewGetterRepo interface {
-	GetByUserID(ctx context.Context, uID string) ([]model.PullRequestShort, error)
+	List(ctx context.Context, filter model.PullRequestFilter) ([]model.PullRequest, error)
 }
 
+const maxPageSize int = 200
+
 // Get forms a report about user's reviews.
-func (r *ReviewGetter) Get(ctx context.Context, uID string) (model.ReviewReport, error) {
+func (r *ReviewGetter) Get(
+	ctx context.Context, uID string, query model.ReviewQuery,
+) (model.ReviewReport, error) {
+	ctx, span := telemetry.Start(ctx, "user.GetReviews", telemetry.User(uID))
+	defer span.End()
+
 	if len(uID) == 0 {
 		return model.ReviewReport{}, model.ErrBadRequest
 	}
 
-	prs, err := r.PR.GetByUserID(ctx, uID)
+	filter, err := reviewFilter(uID, query)
+	if err != nil {
+		return model.ReviewReport{}, telemetry.Error(span, err)
+	}
+
+	prs, err := r.PR.List(ctx, filter)
 	if err != nil {
-		return model.ReviewReport{}, err
+		return model.ReviewReport{}, telemetry.Error(span, err)
 	}
 
-	return model.ReviewReport{
+	report := model.ReviewReport{
 		UserID:       uID,
-		PullRequests: prs,
-	}, nil
+		PullRequests: make([]model.PullRequestShort, 0, len(prs)),
+		NextCursor:   "",
+	}
+	if query.Limit > 0 && len(prs) > query.Limit {
+		prs = prs[:query.Limit]
+		last := prs[len(prs)-1]
+		if last.CreatedAt != nil {
+			report.NextCursor = model.Cursor{CreatedAt: *last.CreatedAt, ID: last.ID}.Encode()
+		}
+	}
+
+	for _, pr := range prs {
+		report.PullRequests = append(report.PullRequests, shorten(pr, query.Details))
+	}
+	return report, nil
+}
+
+func reviewFilter(uID string, query model.ReviewQuery) (model.PullRequestFilter, error) {
+	var filter model.PullRequestFilter
+	switch query.Status {
+	case "", "OPEN", "MERGED":
+		filter.Status = query.Status
+	default:
+		return model.PullRequestFilter{}, model.ErrBadRequest
+	}
+
+	if query.Limit < 0 || query.Limit > maxPageSize {
+		return model.PullRequestFilter{}, model.ErrBadRequest
+	}
+	if query.Limit > 0 {
+		filter.Limit = query.Limit + 1
+	}
+
+	if len(query.Cursor) != 0 {
+		after, err := model.DecodeCursor(query.Cursor)
+		if err != nil {
+			return model.PullRequestFilter{}, err
+		}
+		filter.After = &after
+	}
+
+	filter.ReviewerID = uID
+	filter.Descending = query.Descending
+	return filter, nil
+}
+
+func shorten(pr model.PullRequest, details bool) model.PullRequestShort {
+	short := model.PullRequestShort{
+		ID:        pr.ID,
+		Name:      pr.Name,
+		Author:    pr.AuthorID,
+		Status:    pr.Status,
+		Reviewers: nil,
+		CreatedAt: nil,
+		MergedAt:  nil,
+	}
+	if details {
+		short.Reviewers = pr.Reviewers
+		short.CreatedAt = pr.CreatedAt
+		short.MergedAt = pr.MergedAt
+	}
+	return short
 }
@@ -0,0 +1,173 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//nolint:gochecknoglobals
+var (
+	errInternal = errors.New("internal error")
+	createdAt   = time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	noReport    model.ReviewReport
+)
+
+type prListMockRepo struct {
+	mock.Mock
+}
+
+func (m *prListMockRepo) List(
+	_ context.Context, filter model.PullRequestFilter,
+) ([]model.PullRequest, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]model.PullRequest), args.Error(1)
+}
+
+func samplePR(id string) model.PullRequest {
+	return model.PullRequest{
+		ID:        id,
+		Name:      "PR " + id,
+		AuthorID:  "u1",
+		Status:    "OPEN",
+		Reviewers: []string{"u2"},
+		CreatedAt: &createdAt,
+		MergedAt:  nil,
+	}
+}
+
+func TestGetReviews_Validation(t *testing.T) {
+	var u user.ReviewGetter
+
+	type testCase struct {
+		testName string
+		userID   string
+		query    model.ReviewQuery
+	}
+
+	tests := []testCase{
+		{testName: "Empty user", userID: "", query: model.ReviewQuery{}},
+		{testName: "Unknown status", userID: "u2", query: model.ReviewQuery{Status: "CLOSED"}},
+		{testName: "Negative limit", userID: "u2", query: model.ReviewQuery{Limit: -1}},
+		{testName: "Too big limit", userID: "u2", query: model.ReviewQuery{Limit: 1000}},
+		{testName: "Malformed cursor", userID: "u2", query: model.ReviewQuery{Cursor: "not a cursor"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			report, err := u.Get(t.Context(), test.userID, test.query)
+			assert.ErrorIs(t, err, model.ErrBadRequest)
+			assert.Equal(t, noReport, report)
+		})
+	}
+}
+
+//nolint:exhaustruct
+func TestGetReviews_Filter(t *testing.T) {
+	cursor := model.Cursor{CreatedAt: createdAt, ID: "pr2"}
+
+	type testCase struct {
+		testName string
+		query    model.ReviewQuery
+		filter   model.PullRequestFilter
+	}
+
+	tests := []testCase{
+		{
+			testName: "All reviews",
+			query:    model.ReviewQuery{},
+			filter:   model.PullRequestFilter{ReviewerID: "u2"},
+		},
+		{
+			testName: "Merged newest first",
+			query:    model.ReviewQuery{Status: "MERGED", Descending: true},
+			filter:   model.PullRequestFilter{ReviewerID: "u2", Status: "MERGED", Descending: true},
+		},
+		{
+			testName: "Open oldest first after cursor",
+			query:    model.ReviewQuery{Status: "OPEN", Limit: 10, Cursor: cursor.Encode()},
+			filter:   model.PullRequestFilter{ReviewerID: "u2", Status: "OPEN", Limit: 11, After: &cursor},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			repo := new(prListMockRepo)
+			repo.On("List", test.filter).Return([]model.PullRequest{}, nil)
+
+			u := user.ReviewGetter{PR: repo}
+			report, err := u.Get(t.Context(), "u2", test.query)
+			assert.NoError(t, err)
+			assert.Equal(t, model.ReviewReport{UserID: "u2", PullRequests: []model.PullRequestShort{}}, report)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+//nolint:exhaustruct
+func TestGetReviews_Pagination(t *testing.T) {
+	repo := new(prListMockRepo)
+	prs := []model.PullRequest{samplePR("pr1"), samplePR("pr2"), samplePR("pr3")}
+	repo.On("List", model.PullRequestFilter{ReviewerID: "u2", Limit: 3}).Return(prs, nil)
+
+	u := user.ReviewGetter{PR: repo}
+	report, err := u.Get(t.Context(), "u2", model.ReviewQuery{Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, report.PullRequests, 2)
+	assert.Equal(t, "pr2", report.PullRequests[1].ID)
+
+	cursor, err := model.DecodeCursor(report.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Cursor{CreatedAt: createdAt, ID: "pr2"}, cursor)
+
+	// Last page has no cursor.
+	repo = new(prListMockRepo)
+	repo.On("List", model.PullRequestFilter{ReviewerID: "u2", Limit: 3, After: &cursor}).Return(prs[2:], nil)
+	u = user.ReviewGetter{PR: repo}
+	report, err = u.Get(t.Context(), "u2", model.ReviewQuery{Limit: 2, Cursor: report.NextCursor})
+	assert.NoError(t, err)
+	assert.Len(t, report.PullRequests, 1)
+	assert.Empty(t, report.NextCursor)
+}
+
+//nolint:exhaustruct
+func TestGetReviews_Details(t *testing.T) {
+	merged := samplePR("pr1")
+	merged.Status = "MERGED"
+	mergedAt := createdAt.Add(time.Hour)
+	merged.MergedAt = &mergedAt
+	repo := new(prListMockRepo)
+	repo.On("List", mock.Anything).Return([]model.PullRequest{merged}, nil)
+	u := user.ReviewGetter{PR: repo}
+
+	// Short shape is default.
+	report, err := u.Get(t.Context(), "u2", model.ReviewQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, []model.PullRequestShort{
+		{ID: "pr1", Name: "PR pr1", Author: "u1", Status: "MERGED"},
+	}, report.PullRequests)
+
+	report, err = u.Get(t.Context(), "u2", model.ReviewQuery{Details: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []model.PullRequestShort{{
+		ID: "pr1", Name: "PR pr1", Author: "u1", Status: "MERGED",
+		Reviewers: []string{"u2"}, CreatedAt: &createdAt, MergedAt: &mergedAt,
+	}}, report.PullRequests)
+}
+
+//nolint:exhaustruct
+func TestGetReviews_Errors(t *testing.T) {
+	repo := new(prListMockRepo)
+	repo.On("List", mock.Anything).Return([]model.PullRequest{}, errInternal)
+
+	u := user.ReviewGetter{PR: repo}
+	report, err := u.Get(t.Context(), "u2", model.ReviewQuery{})
+	assert.ErrorIs(t, err, errInternal)
+	assert.Equal(t, noReport, report)
+}
@@ -2,8 +2,10 @@ package user
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
 )
 
 // StatusUpdater provides use case for updating user status.
@@ -20,13 +22,24 @@ type userRepo interface {
 func (r *StatusUpdater) SetIsActive(
 	ctx context.Context, uID string, isActive bool,
 ) (model.User, error) {
+	ctx, span := telemetry.Start(ctx, "user.SetIsActive", telemetry.User(uID))
+	defer span.End()
+
 	if len(uID) == 0 {
 		return model.User{}, model.ErrBadRequest
 	}
 
-	err := r.User.SetIsActive(ctx, uID, isActive)
+	user, err := r.User.Get(ctx, uID)
+	if err != nil {
+		return model.User{}, telemetry.Error(span, err)
+	}
+	if actor, _ := model.ActorFrom(ctx); !actor.CanManageTeam(user.TeamName) {
+		return model.User{}, telemetry.Error(span, fmt.Errorf("manage team of %s: %w", uID, model.ErrForbidden))
+	}
+
+	err = r.User.SetIsActive(ctx, uID, isActive)
 	if err != nil {
-		return model.User{}, err
+		return model.User{}, telemetry.Error(span, err)
 	}
 
 	return r.User.Get(ctx, uID)
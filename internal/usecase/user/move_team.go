@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/telemetry"
+)
+
+// TeamMover provides use case for moving user to another team.
+type TeamMover struct {
+	TX      database.TransactionManager
+	Team    teamRepo
+	User    userMoverRepo
+	Reviews reviewReleaser
+}
+
+type teamRepo interface {
+	Get(ctx context.Context, name string) (model.Team, error)
+}
+
+type userMoverRepo interface {
+	Get(ctx context.Context, id string) (model.User, error)
+	SetTeam(ctx context.Context, uID, teamName string) error
+}
+
+type reviewReleaser interface {
+	Release(ctx context.Context, user model.User, reassign bool) ([]model.ReviewChange, error)
+}
+
+// MoveTeam moves user to team. Open reviews of user are reassigned inside
+// the former team or kept according to reassign.
+func (u *TeamMover) MoveTeam(
+	ctx context.Context, uID, teamName string, reassign bool,
+) (model.MembershipChange, error) {
+	ctx, span := telemetry.Start(ctx, "user.MoveTeam", telemetry.User(uID), telemetry.Team(teamName))
+	defer span.End()
+
+	if len(uID) == 0 || len(teamName) == 0 {
+		return model.MembershipChange{}, model.ErrBadRequest
+	}
+
+	var res model.MembershipChange
+	err := u.TX.WithTransaction(ctx, func(ctx context.Context) error {
+		user, err := u.User.Get(ctx, uID)
+		if err != nil {
+			return err
+		}
+		if user.TeamName == teamName {
+			return model.ErrBadRequest
+		}
+		if actor, _ := model.ActorFrom(ctx); !actor.CanManageTeam(user.TeamName) || !actor.CanManageTeam(teamName) {
+			return fmt.Errorf("move %s from %s to %s: %w", uID, user.TeamName, teamName, model.ErrForbidden)
+		}
+
+		target, err := u.Team.Get(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		if target.Archived {
+			return fmt.Errorf("%s %w", teamName, model.ErrTeamArchived)
+		}
+
+		err = u.User.SetTeam(ctx, uID, teamName)
+		if err != nil {
+			return err
+		}
+
+		changes, err := u.Reviews.Release(ctx, user, reassign)
+		if err != nil {
+			return err
+		}
+
+		moved, err := u.User.Get(ctx, uID)
+		if err != nil {
+			return err
+		}
+
+		res = model.MembershipChange{
+			TeamName: teamName,
+			Users:    []model.User{moved},
+			Affected: changes,
+		}
+		return nil
+	})
+	if err != nil {
+		return model.MembershipChange{}, telemetry.Error(span, err)
+	}
+
+	slog.InfoContext(ctx, "User moved to team",
+		slog.String("user_id", uID),
+		slog.String("team_name", teamName),
+		slog.Int("affected_pull_requests", len(res.Affected)),
+	)
+	return res, nil
+}
@@ -0,0 +1,135 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/usecase/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type teamMockRepo struct {
+	mock.Mock
+}
+
+func (m *teamMockRepo) Get(_ context.Context, name string) (model.Team, error) {
+	args := m.Called(name)
+	return args.Get(0).(model.Team), args.Error(1)
+}
+
+type userMockRepo struct {
+	mock.Mock
+}
+
+func (m *userMockRepo) Get(_ context.Context, id string) (model.User, error) {
+	args := m.Called(id)
+	return args.Get(0).(model.User), args.Error(1)
+}
+
+func (m *userMockRepo) SetTeam(_ context.Context, uID, teamName string) error {
+	args := m.Called(uID, teamName)
+	return args.Error(0)
+}
+
+type reviewReleaserMock struct {
+	mock.Mock
+}
+
+func (m *reviewReleaserMock) Release(
+	_ context.Context, user model.User, reassign bool,
+) ([]model.ReviewChange, error) {
+	args := m.Called(user, reassign)
+	return args.Get(0).([]model.ReviewChange), args.Error(1)
+}
+
+type fakeTransactionManager struct{}
+
+func (tm *fakeTransactionManager) WithTransaction(
+	ctx context.Context, transaction func(context.Context) error,
+) error {
+	return transaction(ctx)
+}
+
+//nolint:gochecknoglobals
+var (
+	bob    = model.User{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team1"}
+	moved  = model.User{UserID: "u2", Username: "Bob", IsActive: true, TeamName: "team2"}
+	team2  = model.Team{TeamName: "team2", Members: nil, Archived: false}
+	noMove model.MembershipChange
+	noTeam model.Team
+)
+
+func TestMoveTeam(t *testing.T) {
+	for _, reassign := range []bool{false, true} {
+		teamRepo := new(teamMockRepo)
+		userRepo := new(userMockRepo)
+		releaser := new(reviewReleaserMock)
+		changes := []model.ReviewChange{{PRID: "pr1", OldUserID: "u2", NewUserID: "", Action: model.ReviewKept}}
+		if reassign {
+			changes[0].NewUserID = "u1"
+			changes[0].Action = model.ReviewReassigned
+		}
+		userRepo.On("Get", "u2").Return(bob, nil).Once()
+		teamRepo.On("Get", "team2").Return(team2, nil)
+		userRepo.On("SetTeam", "u2", "team2").Return(nil)
+		// Reviews are released with former team of user.
+		releaser.On("Release", bob, reassign).Return(changes, nil)
+		userRepo.On("Get", "u2").Return(moved, nil).Once()
+
+		u := user.TeamMover{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+		res, err := u.MoveTeam(t.Context(), "u2", "team2", reassign)
+		assert.NoError(t, err)
+		assert.Equal(t, model.MembershipChange{TeamName: "team2", Users: []model.User{moved}, Affected: changes}, res)
+		teamRepo.AssertExpectations(t)
+		userRepo.AssertExpectations(t)
+		releaser.AssertExpectations(t)
+	}
+}
+
+func TestMoveTeam_TeamArchived(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	releaser := new(reviewReleaserMock)
+	userRepo.On("Get", "u2").Return(bob, nil)
+	teamRepo.On("Get", "team2").Return(model.Team{TeamName: "team2", Members: nil, Archived: true}, nil)
+
+	u := user.TeamMover{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: releaser}
+	res, err := u.MoveTeam(t.Context(), "u2", "team2", true)
+	assert.ErrorIs(t, err, model.ErrTeamArchived)
+	assert.Equal(t, noMove, res)
+	userRepo.AssertNotCalled(t, "SetTeam", "u2", "team2")
+	releaser.AssertExpectations(t)
+}
+
+func TestMoveTeam_Errors(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	userRepo.On("Get", "u2").Return(bob, nil)
+	teamRepo.On("Get", "team3").Return(noTeam, model.ErrNotFound)
+	u := user.TeamMover{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: nil}
+
+	// Moving into the same team and missing arguments are rejected.
+	for _, args := range [][2]string{{"", "team2"}, {"u2", ""}, {"u2", "team1"}} {
+		_, err := u.MoveTeam(t.Context(), args[0], args[1], true)
+		assert.ErrorIs(t, err, model.ErrBadRequest)
+	}
+	_, err := u.MoveTeam(t.Context(), "u2", "team3", true)
+	assert.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestMoveTeam_Access(t *testing.T) {
+	teamRepo := new(teamMockRepo)
+	userRepo := new(userMockRepo)
+	userRepo.On("Get", "u2").Return(bob, nil)
+	u := user.TeamMover{TX: &fakeTransactionManager{}, Team: teamRepo, User: userRepo, Reviews: nil}
+
+	// Lead of target team can't take users from other teams.
+	ctx := model.WithActor(t.Context(), model.Actor{ //nolint:exhaustruct
+		UserID: "u5", Role: model.RoleTeamLead, Team: "team2",
+	})
+	_, err := u.MoveTeam(ctx, "u2", "team2", true)
+	assert.ErrorIs(t, err, model.ErrForbidden)
+	teamRepo.AssertNotCalled(t, "Get", "team2")
+}
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS users_team_idx;
+DROP INDEX IF EXISTS users_to_pull_requests_reviewer_idx;
+DROP INDEX IF EXISTS pull_request_merged_at_idx;
+DROP INDEX IF EXISTS pull_request_status_idx;
+DROP INDEX IF EXISTS pull_request_author_idx;
+DROP INDEX IF EXISTS pull_request_created_at_idx;
@@ -0,0 +1,6 @@
+CREATE INDEX IF NOT EXISTS pull_request_created_at_idx ON PullRequest (created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS pull_request_author_idx ON PullRequest (author_id);
+CREATE INDEX IF NOT EXISTS pull_request_status_idx ON PullRequest (status);
+CREATE INDEX IF NOT EXISTS pull_request_merged_at_idx ON PullRequest (merged_at);
+CREATE INDEX IF NOT EXISTS users_to_pull_requests_reviewer_idx ON UsersToPullRequests (reviewer_id);
+CREATE INDEX IF NOT EXISTS users_team_idx ON Users (team);
@@ -0,0 +1,12 @@
+-- Members of deleted teams have no team, they are kept with their pull requests in placeholder team.
+INSERT INTO Team (name)
+SELECT 'unassigned' WHERE EXISTS (SELECT 1 FROM Users WHERE team IS NULL)
+ON CONFLICT (name) DO NOTHING;
+UPDATE Users SET team = 'unassigned', is_active = false WHERE team IS NULL;
+
+ALTER TABLE Users DROP CONSTRAINT IF EXISTS users_team_fkey;
+ALTER TABLE Users ADD CONSTRAINT users_team_fkey
+    FOREIGN KEY (team) REFERENCES Team(name);
+ALTER TABLE Users ALTER COLUMN team SET NOT NULL;
+
+ALTER TABLE Team DROP COLUMN IF EXISTS archived;
@@ -0,0 +1,8 @@
+ALTER TABLE Team ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE Users ALTER COLUMN team DROP NOT NULL;
+ALTER TABLE Users DROP CONSTRAINT IF EXISTS users_team_fkey;
+ALTER TABLE Users ADD CONSTRAINT users_team_fkey
+    FOREIGN KEY (team) REFERENCES Team(name)
+    ON UPDATE CASCADE
+    ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idempotency_key_created_at_idx;
+DROP TABLE IF EXISTS IdempotencyKey;
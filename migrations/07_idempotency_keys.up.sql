@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS IdempotencyKey (
+    idempotency_key TEXT NOT NULL,
+    route TEXT NOT NULL,
+    request_hash BYTEA NOT NULL,
+    status_code INTEGER,
+    content_type TEXT,
+    response_body BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (idempotency_key, route)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_key_created_at_idx ON IdempotencyKey (created_at);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS ApiKey (
+    key_id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    key_hash BYTEA NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP
+);
@@ -0,0 +1,51 @@
+-- Names are unique only within organization, so data of other organizations is dropped.
+DELETE FROM UsersToPullRequests WHERE org_id <> 'default';
+DELETE FROM PullRequest WHERE org_id <> 'default';
+DELETE FROM Users WHERE org_id <> 'default';
+DELETE FROM Team WHERE org_id <> 'default';
+DELETE FROM ApiKey WHERE org_id <> 'default';
+
+DROP INDEX IF EXISTS api_key_org_idx;
+DROP INDEX IF EXISTS users_team_idx;
+DROP INDEX IF EXISTS users_to_pull_requests_reviewer_idx;
+DROP INDEX IF EXISTS pull_request_author_idx;
+DROP INDEX IF EXISTS pull_request_created_at_idx;
+
+ALTER TABLE UsersToPullRequests
+    DROP CONSTRAINT IF EXISTS userstopullrequests_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS userstopullrequests_reviewer_id_fkey,
+    DROP CONSTRAINT IF EXISTS userstopullrequests_pkey;
+ALTER TABLE PullRequest
+    DROP CONSTRAINT IF EXISTS pullrequest_author_id_fkey,
+    DROP CONSTRAINT IF EXISTS pullrequest_pkey;
+ALTER TABLE Users
+    DROP CONSTRAINT IF EXISTS users_team_fkey,
+    DROP CONSTRAINT IF EXISTS users_pkey;
+ALTER TABLE Team DROP CONSTRAINT IF EXISTS team_pkey;
+
+ALTER TABLE Team ADD CONSTRAINT team_pkey PRIMARY KEY (name);
+ALTER TABLE Users ADD CONSTRAINT users_pkey PRIMARY KEY (user_id);
+ALTER TABLE Users ADD CONSTRAINT users_team_fkey
+    FOREIGN KEY (team) REFERENCES Team(name)
+    ON UPDATE CASCADE
+    ON DELETE SET NULL;
+ALTER TABLE PullRequest ADD CONSTRAINT pullrequest_pkey PRIMARY KEY (pull_request_id);
+ALTER TABLE PullRequest ADD CONSTRAINT pullrequest_author_id_fkey
+    FOREIGN KEY (author_id) REFERENCES Users(user_id);
+ALTER TABLE UsersToPullRequests ADD CONSTRAINT userstopullrequests_pkey
+    PRIMARY KEY (pull_request_id, reviewer_id);
+ALTER TABLE UsersToPullRequests ADD CONSTRAINT userstopullrequests_pull_request_id_fkey
+    FOREIGN KEY (pull_request_id) REFERENCES PullRequest(pull_request_id);
+ALTER TABLE UsersToPullRequests ADD CONSTRAINT userstopullrequests_reviewer_id_fkey
+    FOREIGN KEY (reviewer_id) REFERENCES Users(user_id);
+
+ALTER TABLE ApiKey DROP COLUMN IF EXISTS org_id;
+ALTER TABLE UsersToPullRequests DROP COLUMN IF EXISTS org_id;
+ALTER TABLE PullRequest DROP COLUMN IF EXISTS org_id;
+ALTER TABLE Users DROP COLUMN IF EXISTS org_id;
+ALTER TABLE Team DROP COLUMN IF EXISTS org_id;
+
+CREATE INDEX IF NOT EXISTS pull_request_created_at_idx ON PullRequest (created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS pull_request_author_idx ON PullRequest (author_id);
+CREATE INDEX IF NOT EXISTS users_to_pull_requests_reviewer_idx ON UsersToPullRequests (reviewer_id);
+CREATE INDEX IF NOT EXISTS users_team_idx ON Users (team);
@@ -0,0 +1,53 @@
+-- Existing data belongs to default organization.
+ALTER TABLE Team ADD COLUMN IF NOT EXISTS org_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE Users ADD COLUMN IF NOT EXISTS org_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE PullRequest ADD COLUMN IF NOT EXISTS org_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE UsersToPullRequests ADD COLUMN IF NOT EXISTS org_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE ApiKey ADD COLUMN IF NOT EXISTS org_id TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE UsersToPullRequests
+    DROP CONSTRAINT IF EXISTS userstopullrequests_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS userstopullrequests_reviewer_id_fkey,
+    DROP CONSTRAINT IF EXISTS userstopullrequests_pkey;
+ALTER TABLE PullRequest
+    DROP CONSTRAINT IF EXISTS pullrequest_author_id_fkey,
+    DROP CONSTRAINT IF EXISTS pullrequest_pkey;
+ALTER TABLE Users
+    DROP CONSTRAINT IF EXISTS users_team_fkey,
+    DROP CONSTRAINT IF EXISTS users_pkey;
+ALTER TABLE Team DROP CONSTRAINT IF EXISTS team_pkey;
+
+-- Names are unique within organization, and references never leave it,
+-- so reviewer can't be assigned to pull request of another organization.
+ALTER TABLE Team ADD CONSTRAINT team_pkey PRIMARY KEY (org_id, name);
+ALTER TABLE Users ADD CONSTRAINT users_pkey PRIMARY KEY (org_id, user_id);
+ALTER TABLE Users ADD CONSTRAINT users_team_fkey
+    FOREIGN KEY (org_id, team) REFERENCES Team(org_id, name)
+    ON UPDATE CASCADE
+    ON DELETE SET NULL (team);
+ALTER TABLE PullRequest ADD CONSTRAINT pullrequest_pkey PRIMARY KEY (org_id, pull_request_id);
+ALTER TABLE PullRequest ADD CONSTRAINT pullrequest_author_id_fkey
+    FOREIGN KEY (org_id, author_id) REFERENCES Users(org_id, user_id);
+ALTER TABLE UsersToPullRequests ADD CONSTRAINT userstopullrequests_pkey
+    PRIMARY KEY (org_id, pull_request_id, reviewer_id);
+ALTER TABLE UsersToPullRequests ADD CONSTRAINT userstopullrequests_pull_request_id_fkey
+    FOREIGN KEY (org_id, pull_request_id) REFERENCES PullRequest(org_id, pull_request_id);
+ALTER TABLE UsersToPullRequests ADD CONSTRAINT userstopullrequests_reviewer_id_fkey
+    FOREIGN KEY (org_id, reviewer_id) REFERENCES Users(org_id, user_id);
+
+-- New rows must name organization explicitly.
+ALTER TABLE Team ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE Users ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE PullRequest ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE UsersToPullRequests ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE ApiKey ALTER COLUMN org_id DROP DEFAULT;
+
+DROP INDEX IF EXISTS pull_request_created_at_idx;
+DROP INDEX IF EXISTS pull_request_author_idx;
+DROP INDEX IF EXISTS users_to_pull_requests_reviewer_idx;
+DROP INDEX IF EXISTS users_team_idx;
+CREATE INDEX IF NOT EXISTS pull_request_created_at_idx ON PullRequest (org_id, created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS pull_request_author_idx ON PullRequest (org_id, author_id);
+CREATE INDEX IF NOT EXISTS users_to_pull_requests_reviewer_idx ON UsersToPullRequests (org_id, reviewer_id);
+CREATE INDEX IF NOT EXISTS users_team_idx ON Users (org_id, team);
+CREATE INDEX IF NOT EXISTS api_key_org_idx ON ApiKey (org_id, created_at);
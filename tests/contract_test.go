@@ -0,0 +1,285 @@
+package tests_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LeonovDS/review-manager/api"
+	"github.com/LeonovDS/review-manager/internal/contract"
+)
+
+// contractClient sends requests to router and checks every response against specification,
+// so mismatch is reported with the field that broke the contract.
+type contractClient struct {
+	t         *testing.T
+	mux       http.Handler
+	validator *contract.Validator
+	// called is set of operations in form "METHOD /path".
+	called map[string]bool
+}
+
+type call struct {
+	method      string
+	target      string
+	contentType string
+	body        string
+	header      http.Header
+	status      int
+}
+
+func newRequest(c call) *http.Request {
+	r := httptest.NewRequest(c.method, c.target, strings.NewReader(c.body))
+	if len(c.body) != 0 {
+		contentType := c.contentType
+		if len(contentType) == 0 {
+			contentType = "application/json"
+		}
+		r.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range c.header {
+		r.Header[k] = v
+	}
+	return r
+}
+
+func (c *contractClient) do(step call) *httptest.ResponseRecorder {
+	c.t.Helper()
+	rr := httptest.NewRecorder()
+	c.mux.ServeHTTP(rr, newRequest(step))
+
+	req := newRequest(step)
+	c.called[req.Method+" "+req.URL.Path] = true
+	assert.Equal(c.t, step.status, rr.Code, "%s %s: %s", step.method, step.target, rr.Body.String())
+
+	op, err := c.validator.Request(req)
+	if err != nil {
+		// Requests that violate contract are rejected before reaching handlers.
+		assert.Equal(c.t, http.StatusBadRequest, step.status, "%s %s: %v", step.method, step.target, err)
+		assert.Contains(c.t, rr.Body.String(), `"code":"BAD_REQUEST"`)
+		return rr
+	}
+	err = op.Response(c.t.Context(), rr.Code, rr.Header(), rr.Body.Bytes())
+	assert.NoError(c.t, err, "%s %s: response violates contract", step.method, step.target)
+	return rr
+}
+
+// specOperations lists every operation of specification in form "METHOD /path".
+func specOperations(t *testing.T) []string {
+	t.Helper()
+	doc, err := openapi3.NewLoader().LoadFromData(api.Spec)
+	require.NoError(t, err)
+
+	var ops []string
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			ops = append(ops, method+" "+path)
+		}
+	}
+	slices.Sort(ops)
+	return ops
+}
+
+//nolint:funlen,maintidx // one scenario walks through the whole API
+func TestContract(t *testing.T) {
+	runTest(t, func(t *testing.T, mux http.Handler) {
+		validator, err := contract.New(true)
+		require.NoError(t, err)
+		c := &contractClient{t: t, mux: mux, validator: validator, called: map[string]bool{}}
+		get, post := http.MethodGet, http.MethodPost
+		steps := []call{
+			{method: get, target: "/health/live", status: http.StatusOK},
+			{method: get, target: "/health/ready", status: http.StatusOK},
+
+			{method: post, target: "/team/add", status: http.StatusCreated, body: `{"team_name":"backend","members":[
+				{"user_id":"u1","username":"Alice","is_active":true},
+				{"user_id":"u2","username":"Bob","is_active":true},
+				{"user_id":"u3","username":"Carol","is_active":true},
+				{"user_id":"u4","username":"Dave","is_active":false}]}`},
+			{method: post, target: "/team/add", status: http.StatusBadRequest,
+				body: `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`},
+			{method: post, target: "/team/add", status: http.StatusCreated, body: `{"team_name":"payments","members":[
+				{"user_id":"u5","username":"Eve","is_active":true},
+				{"user_id":"u6","username":"Frank","is_active":true}]}`},
+			{method: post, target: "/team/add", status: http.StatusBadRequest, body: `{"team_name":"qa"}`},
+			{method: get, target: "/team/get?team_name=backend", status: http.StatusOK},
+			{method: get, target: "/team/get?team_name=unknown", status: http.StatusNotFound},
+			{method: get, target: "/team/get", status: http.StatusBadRequest},
+
+			{method: post, target: "/users/setIsActive", status: http.StatusOK, body: `{"user_id":"u4","is_active":true}`},
+			{method: post, target: "/users/setIsActive", status: http.StatusNotFound,
+				body: `{"user_id":"unknown","is_active":false}`},
+
+			{method: post, target: "/pullRequest/create", status: http.StatusCreated,
+				body: `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`},
+			{method: post, target: "/pullRequest/create", status: http.StatusConflict,
+				body: `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`},
+			{method: post, target: "/pullRequest/create", status: http.StatusNotFound,
+				body: `{"pull_request_id":"pr-2","pull_request_name":"Fix","author_id":"unknown"}`},
+			{method: get, target: "/pullRequest/get?pull_request_id=unknown", status: http.StatusNotFound},
+			{method: get, target: "/pullRequest/list", status: http.StatusOK},
+			{method: get, target: "/pullRequest/list?status=OPEN&team_name=backend&limit=1", status: http.StatusOK},
+			{method: get, target: "/pullRequest/list?limit=0", status: http.StatusBadRequest},
+			{method: get, target: "/users/getReview?user_id=u2", status: http.StatusOK},
+			{method: get, target: "/users/getReview?user_id=u2&include=details&order=desc&limit=1", status: http.StatusOK},
+
+			// u2 and u3 are reviewers, u4 is the only candidate.
+			{method: post, target: "/pullRequest/reassign", status: http.StatusOK,
+				body: `{"pull_request_id":"pr-1","old_user_id":"u2"}`},
+			{method: post, target: "/pullRequest/reassign", status: http.StatusConflict,
+				body: `{"pull_request_id":"pr-1","old_user_id":"u1"}`},
+			{method: post, target: "/pullRequest/merge", status: http.StatusOK, body: `{"pull_request_id":"pr-1"}`},
+			{method: post, target: "/pullRequest/merge", status: http.StatusOK, body: `{"pull_request_id":"pr-1"}`},
+			{method: post, target: "/pullRequest/merge", status: http.StatusNotFound, body: `{"pull_request_id":"unknown"}`},
+			{method: post, target: "/pullRequest/reassign", status: http.StatusConflict,
+				body: `{"pull_request_id":"pr-1","old_user_id":"u3"}`},
+
+			{method: post, target: "/team/rename", status: http.StatusOK,
+				body: `{"team_name":"backend","new_team_name":"platform"}`},
+			{method: post, target: "/team/rename", status: http.StatusBadRequest,
+				body: `{"team_name":"platform","new_team_name":"payments"}`},
+			{method: post, target: "/team/rename", status: http.StatusNotFound,
+				body: `{"team_name":"unknown","new_team_name":"other"}`},
+			{method: post, target: "/team/addMembers", status: http.StatusOK,
+				body: `{"team_name":"platform","members":[{"user_id":"u7","username":"Grace","is_active":true}]}`},
+			{method: post, target: "/team/addMembers", status: http.StatusConflict,
+				body: `{"team_name":"platform","members":[{"user_id":"u5","username":"Eve","is_active":true}]}`},
+			{method: post, target: "/users/moveTeam", status: http.StatusOK,
+				body: `{"user_id":"u7","team_name":"payments","reassign_reviews":true}`},
+			{method: post, target: "/users/moveTeam", status: http.StatusNotFound,
+				body: `{"user_id":"unknown","team_name":"payments"}`},
+			{method: post, target: "/team/removeMembers", status: http.StatusOK,
+				body: `{"team_name":"payments","user_ids":["u7"],"reassign_reviews":true}`},
+
+			{method: post, target: "/team/sync?dry_run=true", status: http.StatusOK,
+				body: `{"teams":[{"team_name":"platform","members":[{"user_id":"u1","username":"Alice","is_active":true}]}]}`},
+			{method: post, target: "/team/sync?dry_run=true", status: http.StatusOK, contentType: "application/yaml",
+				body: "teams:\n  - team_name: mobile\n    members:\n      - {user_id: u9, username: Ivan, is_active: true}\n"},
+
+			{method: post, target: "/import/users", status: http.StatusOK, contentType: "text/csv",
+				body: "user_id,username,team_name,is_active\nu8,Heidi,qa,true\n"},
+			{method: post, target: "/import/users", status: http.StatusBadRequest, contentType: "text/csv",
+				body: "user_id,username,team_name,is_active\nu9,,qa,true\n"},
+			{method: get, target: "/export/users", status: http.StatusOK},
+			{method: get, target: "/export/pullRequests", status: http.StatusOK},
+
+			{method: post, target: "/pullRequest/create", status: http.StatusCreated,
+				body: `{"pull_request_id":"pr-2","pull_request_name":"Fix search","author_id":"u1"}`},
+			{method: post, target: "/team/delete", status: http.StatusConflict, body: `{"team_name":"platform"}`},
+			{method: post, target: "/team/archive", status: http.StatusOK, body: `{"team_name":"payments"}`},
+			{method: post, target: "/team/archive", status: http.StatusNotFound, body: `{"team_name":"unknown"}`},
+			{method: post, target: "/team/addMembers", status: http.StatusConflict,
+				body: `{"team_name":"payments","members":[{"user_id":"u10","username":"Judy","is_active":true}]}`},
+			{method: post, target: "/team/delete", status: http.StatusOK, body: `{"team_name":"payments"}`},
+			{method: post, target: "/team/delete", status: http.StatusNotFound, body: `{"team_name":"payments"}`},
+
+			// Retry with the same Idempotency-Key replays response instead of PR_EXISTS.
+			{method: post, target: "/pullRequest/create", status: http.StatusCreated,
+				header: http.Header{"Idempotency-Key": {"create-pr-3"}},
+				body:   `{"pull_request_id":"pr-3","pull_request_name":"Retry","author_id":"u1"}`},
+			{method: post, target: "/pullRequest/create", status: http.StatusCreated,
+				header: http.Header{"Idempotency-Key": {"create-pr-3"}},
+				body:   `{"pull_request_id":"pr-3","pull_request_name":"Retry","author_id":"u1"}`},
+			{method: post, target: "/pullRequest/create", status: http.StatusUnprocessableEntity,
+				header: http.Header{"Idempotency-Key": {"create-pr-3"}},
+				body:   `{"pull_request_id":"pr-4","pull_request_name":"Retry","author_id":"u1"}`},
+
+			{method: get, target: "/metrics", status: http.StatusOK},
+			// Stream itself never ends, it is checked in TestEventStream.
+			{method: get, target: "/events/stream?user_id=bad%20id", status: http.StatusBadRequest},
+		}
+		for _, step := range steps {
+			c.do(step)
+		}
+
+		rr := c.do(call{method: get, target: "/pullRequest/get?pull_request_id=pr-1", status: http.StatusOK})
+		etag := rr.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		c.do(call{method: get, target: "/pullRequest/get?pull_request_id=pr-1", status: http.StatusNotModified,
+			header: http.Header{"If-None-Match": {etag}}})
+
+		// Key with read scope can read, but can't change pull requests, revoked key is rejected.
+		rr = c.do(call{method: post, target: "/apiKey/create", status: http.StatusCreated,
+			body: `{"name":"dashboard","scopes":["read"]}`})
+		var issued struct {
+			Key struct {
+				KeyID string `json:"key_id"`
+			} `json:"key"`
+			Token string `json:"token"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &issued))
+		reader := http.Header{"Authorization": {"Bearer " + issued.Token}}
+		c.do(call{method: get, target: "/pullRequest/list", status: http.StatusOK, header: reader})
+		c.do(call{method: post, target: "/pullRequest/merge", status: http.StatusForbidden, header: reader,
+			body: `{"pull_request_id":"pr-2"}`})
+		c.do(call{method: get, target: "/apiKey/list", status: http.StatusOK})
+		c.do(call{method: post, target: "/apiKey/revoke", status: http.StatusOK,
+			body: `{"key_id":"` + issued.Key.KeyID + `"}`})
+		c.do(call{method: post, target: "/apiKey/revoke", status: http.StatusNotFound, body: `{"key_id":"unknown"}`})
+		c.do(call{method: get, target: "/pullRequest/list", status: http.StatusUnauthorized, header: reader})
+		c.do(call{method: get, target: "/pullRequest/list", status: http.StatusUnauthorized,
+			header: http.Header{"Authorization": {"Bearer rm_unknown"}}})
+		c.do(call{method: get, target: "/health/live", status: http.StatusOK,
+			header: http.Header{"Authorization": {""}}})
+
+		// Engineers call API with JWT: members work with own pull requests, leads manage own team.
+		bearer := func(userID string, roles ...string) http.Header {
+			return http.Header{"Authorization": {"Bearer " + userToken(t, userID, roles...)}}
+		}
+		c.do(call{method: get, target: "/team/get?team_name=platform", status: http.StatusOK, header: bearer("u8")})
+		c.do(call{method: get, target: "/team/get?team_name=platform", status: http.StatusUnauthorized,
+			header: bearer("ghost")})
+		c.do(call{method: post, target: "/pullRequest/reassign", status: http.StatusForbidden, header: bearer("u8"),
+			body: `{"pull_request_id":"pr-2","old_user_id":"u2"}`})
+		c.do(call{method: post, target: "/team/rename", status: http.StatusForbidden, header: bearer("u8"),
+			body: `{"team_name":"qa","new_team_name":"quality"}`})
+		c.do(call{method: post, target: "/team/rename", status: http.StatusForbidden, header: bearer("u8", "team_lead"),
+			body: `{"team_name":"platform","new_team_name":"core"}`})
+		c.do(call{method: post, target: "/users/setIsActive", status: http.StatusOK, header: bearer("u8", "team_lead"),
+			body: `{"user_id":"u8","is_active":true}`})
+		c.do(call{method: post, target: "/apiKey/create", status: http.StatusForbidden, header: bearer("u8", "team_lead"),
+			body: `{"name":"lead","scopes":["read"]}`})
+		c.do(call{method: post, target: "/team/rename", status: http.StatusOK, header: bearer("u1", "admin"),
+			body: `{"team_name":"qa","new_team_name":"quality"}`})
+
+		// Second organization reuses team name and user ids, but sees and assigns only its own data.
+		second := http.Header{orgHeader: {secondOrg}}
+		c.do(call{method: post, target: "/team/add", status: http.StatusCreated, header: second,
+			body: `{"team_name":"platform","members":[
+				{"user_id":"u1","username":"Mallory","is_active":true},
+				{"user_id":"u2","username":"Niaj","is_active":true}]}`})
+		rr = c.do(call{method: post, target: "/pullRequest/create", status: http.StatusCreated, header: second,
+			body: `{"pull_request_id":"pr-1","pull_request_name":"Other search","author_id":"u1"}`})
+		var created struct {
+			PR struct {
+				Reviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+		assert.Equal(t, []string{"u2"}, created.PR.Reviewers)
+		c.do(call{method: get, target: "/team/get?team_name=quality", status: http.StatusNotFound, header: second})
+		c.do(call{method: get, target: "/pullRequest/get?pull_request_id=pr-2", status: http.StatusNotFound,
+			header: second})
+		c.do(call{method: post, target: "/pullRequest/reassign", status: http.StatusConflict, header: second,
+			body: `{"pull_request_id":"pr-1","old_user_id":"u2"}`})
+		c.do(call{method: get, target: "/team/get?team_name=platform", status: http.StatusOK,
+			header: http.Header{"Authorization": {"Bearer " + orgUserToken(t, secondOrg, "u2")}}})
+		c.do(call{method: get, target: "/team/get?team_name=platform", status: http.StatusUnauthorized,
+			header: http.Header{"Authorization": {"Bearer " + orgUserToken(t, secondOrg, "u8")}}})
+
+		var missed []string
+		for _, op := range specOperations(t) {
+			if !c.called[op] {
+				missed = append(missed, op)
+			}
+		}
+		assert.Empty(t, missed, "operations are not covered by contract test")
+	})
+}
@@ -1,16 +1,36 @@
 package tests_test
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/LeonovDS/review-manager/internal/contract"
 	"github.com/LeonovDS/review-manager/internal/database"
+	"github.com/LeonovDS/review-manager/internal/events"
 	"github.com/LeonovDS/review-manager/internal/handlers"
+	"github.com/LeonovDS/review-manager/internal/metrics"
 	"github.com/LeonovDS/review-manager/internal/model"
+	"github.com/LeonovDS/review-manager/internal/oidc"
+	"github.com/LeonovDS/review-manager/internal/repository"
+	"github.com/LeonovDS/review-manager/internal/usecase/apikey"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 )
@@ -21,7 +41,91 @@ const (
 	dbName     string = "test"
 )
 
-func runTest(t *testing.T, f func(t *testing.T, mux *http.ServeMux)) {
+const (
+	// secondOrg is organization that has its own admin key in tests.
+	secondOrg = "second"
+	// orgHeader selects organization of admin key used for request without Authorization header.
+	orgHeader = "Test-Org"
+)
+
+// jwtKey signs tokens of engineers in tests, router gets its public part as JWKS file.
+//
+//nolint:gochecknoglobals // key is generated once for all tests
+var jwtKey = sync.OnceValue(func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd // minimal safe RSA size
+	if err != nil {
+		panic(err)
+	}
+	return key
+})
+
+// userToken signs JWT of engineer of default organization with given roles.
+func userToken(t *testing.T, userID string, roles ...string) string {
+	t.Helper()
+	return orgUserToken(t, "", userID, roles...)
+}
+
+// orgUserToken signs JWT of engineer of organization, empty org omits claim.
+func orgUserToken(t *testing.T, org, userID string, roles ...string) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: jwtKey()},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "tests"))
+	require.NoError(t, err)
+	claims := map[string]any{
+		"sub":   userID,
+		"roles": roles,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	if len(org) != 0 {
+		claims["org"] = org
+	}
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+// newVerifier writes JWKS with public part of jwtKey and loads it.
+func newVerifier(t *testing.T) *oidc.Verifier {
+	t.Helper()
+	keys := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key: jwtKey().Public(), KeyID: "tests", Algorithm: string(jose.RS256), Use: "sig",
+	}}}
+	data, err := json.Marshal(keys)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	verifier, err := oidc.New(t.Context(), oidc.Config{
+		JWKS: path, Issuer: "", Audience: "", UserClaim: "sub", RolesClaim: "roles", OrgClaim: "org",
+	})
+	require.NoError(t, err)
+	return verifier
+}
+
+// listenEvents feeds broker with events published through database, like every replica does.
+// It returns once listener receives events, so events of requests made after it are not missed.
+func listenEvents(t *testing.T, ctx context.Context, repo *repository.Events) *events.Broker {
+	t.Helper()
+	broker := events.NewBroker()
+	go func() { _ = repo.Listen(ctx, broker.Publish) }()
+
+	probe := broker.Subscribe("probe")
+	defer probe.Close()
+	for {
+		require.NoError(t, repo.Publish(ctx, model.AssignmentEvent{Org: "probe"})) //nolint:exhaustruct
+		select {
+		case <-probe.Events():
+			return broker
+		case <-time.After(100 * time.Millisecond): //nolint:mnd
+		case <-ctx.Done():
+			t.Fatal("Listener of events is not ready")
+		}
+	}
+}
+
+// startDatabase runs PostgreSQL with applied migrations for the test and connects to it.
+func startDatabase(t *testing.T) *pgxpool.Pool {
+	t.Helper()
 	ctx := t.Context()
 	container, err := postgres.Run(ctx, "postgres:latest",
 		postgres.WithDatabase(dbName),
@@ -31,32 +135,82 @@ func runTest(t *testing.T, f func(t *testing.T, mux *http.ServeMux)) {
 	)
 	if err != nil {
 		t.Fatal("Can't run container", "err", err)
-		return
 	}
-	defer func() {
+	t.Cleanup(func() {
 		_ = testcontainers.TerminateContainer(container)
-	}()
+	})
 
 	connStr, err := container.ConnectionString(ctx, "sslmode=disable")
 	if err != nil {
 		t.Fatal("Can't generate connection string", "err", err)
-		return
 	}
 
-	pool, err := database.Connect(ctx, connStr, "file://../migrations/")
+	err = database.MigrateUp(connStr, "file://../migrations/")
+	if err != nil {
+		t.Fatal("Can't apply migrations", "err", err)
+	}
+
+	pool, err := database.Connect(ctx, connStr, database.PoolLimits{})
 	if err != nil {
 		t.Fatal("Can't connect to database", "err", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func runTest(t *testing.T, f func(t *testing.T, mux http.Handler)) {
+	ctx := t.Context()
+	pool := startDatabase(t)
+
+	// Every response in tests is checked against OpenAPI specification.
+	validator, err := contract.New(true)
+	if err != nil {
+		t.Fatal("Can't load OpenAPI specification", "err", err)
 		return
 	}
-	defer func() { pool.Close() }()
-	router := handlers.NewRouter(pool)
+	security, err := contract.Security()
+	if err != nil {
+		t.Fatal("Can't load security requirements", "err", err)
+		return
+	}
+	opts := handlers.DefaultOptions()
+	opts.Contract = validator
+	opts.Metrics = metrics.New()
+	opts.Security = security
+	opts.JWT = newVerifier(t)
+	listenCtx, stopListening := context.WithCancel(ctx)
+	defer stopListening()
+	opts.Events = listenEvents(t, listenCtx, &repository.Events{Pool: pool})
+	defer opts.Events.Close()
+	router := handlers.NewRouter(pool, opts)
 
-	f(t, router)
+	// Requests without Authorization header are sent on behalf of key with every scope
+	// in organization named by orgHeader or in default one.
+	admins := map[string]string{}
+	for _, org := range []string{model.DefaultOrg, secondOrg} {
+		admin, err := (&apikey.Creator{Key: &repository.APIKey{Pool: pool}}).Create(
+			model.WithOrg(ctx, org), "tests", model.Scopes())
+		if err != nil {
+			t.Fatal("Can't create API key", "err", err)
+			return
+		}
+		admins[org] = admin.Token
+	}
+	f(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		org := r.Header.Get(orgHeader)
+		if len(org) == 0 {
+			org = model.DefaultOrg
+		}
+		if _, ok := r.Header["Authorization"]; !ok {
+			r.Header.Set("Authorization", "Bearer "+admins[org])
+		}
+		router.ServeHTTP(w, r)
+	}))
 }
 
 // nolint:exhaustruct
 func TestAddTeam(t *testing.T) {
-	runTest(t, func(t *testing.T, mux *http.ServeMux) {
+	runTest(t, func(t *testing.T, mux http.Handler) {
 		team1 := model.Team{
 			TeamName: "team1",
 			Members: []model.User{
@@ -67,15 +221,142 @@ func TestAddTeam(t *testing.T) {
 
 		team1Json, _ := json.Marshal(team1)
 		req := httptest.NewRequest(http.MethodPost, "/team/add", bytes.NewReader(team1Json))
+		req.Header.Set("Content-Type", "application/json")
 		rr := httptest.NewRecorder()
 		mux.ServeHTTP(rr, req)
-		var resp model.Team
+		var resp struct {
+			Team model.Team `json:"team"`
+		}
 		err := json.Unmarshal(rr.Body.Bytes(), &resp)
 		if err != nil {
 			assert.NoError(t, err, "Should return correct json")
 		}
 
 		assert.Equal(t, rr.Code, http.StatusCreated)
-		assert.Equal(t, team1, resp)
+		assert.Equal(t, team1, resp.Team)
+	})
+}
+
+// readMessage reads lines of event stream up to empty line that ends message.
+func readMessage(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var msg strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		if line == "\n" {
+			return msg.String()
+		}
+		msg.WriteString(line)
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	runTest(t, func(t *testing.T, mux http.Handler) {
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+		send := func(target, body string) {
+			req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+			require.Less(t, rr.Code, http.StatusBadRequest, rr.Body.String())
+		}
+		send("/team/add", `{"team_name":"backend","members":[`+
+			`{"user_id":"u1","username":"Alice","is_active":true},`+
+			`{"user_id":"u2","username":"Bob","is_active":true}]}`)
+
+		resp, err := http.Get(srv.URL + "/events/stream?user_id=u2") //nolint:noctx
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body := bufio.NewReader(resp.Body)
+		assert.Equal(t, "retry: 3000\n", readMessage(t, body))
+
+		send("/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`)
+		send("/pullRequest/merge", `{"pull_request_id":"pr-1"}`)
+		// Repeated merge changes nothing and is not streamed.
+		send("/pullRequest/merge", `{"pull_request_id":"pr-1"}`)
+
+		assigned := readMessage(t, body)
+		assert.True(t, strings.HasPrefix(assigned, "event: ASSIGNED\n"), assigned)
+		assert.Contains(t, assigned, `"reviewer_id":"u2"`)
+		assert.Contains(t, assigned, `"team_name":"backend"`)
+		merged := readMessage(t, body)
+		assert.True(t, strings.HasPrefix(merged, "event: MERGED\n"), merged)
+		assert.Contains(t, merged, `"pull_request_id":"pr-1"`)
+		assert.Contains(t, merged, `"reviewer_id":"u2"`)
 	})
 }
+
+// Events of one change may not fit into single NOTIFY, e.g. when user with many reviews leaves team.
+func TestPublishManyEvents(t *testing.T) {
+	pool := startDatabase(t)
+	ctx := t.Context()
+	repo := &repository.Events{Pool: pool}
+	listenCtx, stopListening := context.WithCancel(ctx)
+	defer stopListening()
+	broker := listenEvents(t, listenCtx, repo)
+	sub := broker.Subscribe(model.DefaultOrg)
+	defer sub.Close()
+
+	sent := make([]model.AssignmentEvent, 0, 60)
+	for i := range cap(sent) {
+		sent = append(sent, model.AssignmentEvent{
+			Type: model.AssignmentReassigned, Org: model.DefaultOrg, PRID: "pr-" + strconv.Itoa(i), AuthorID: "u1",
+			TeamName: "backend", ReviewerID: "u3", PreviousID: "u2", Time: time.Now().UTC().Truncate(time.Microsecond),
+		})
+	}
+	payload, err := json.Marshal(sent)
+	require.NoError(t, err)
+	require.Greater(t, len(payload), 8000)
+
+	tm := database.DBTransactionManager{Pool: pool}
+	require.NoError(t, tm.WithTransaction(ctx, func(ctx context.Context) error {
+		return repo.Publish(ctx, sent...)
+	}))
+
+	received := make([]model.AssignmentEvent, 0, len(sent))
+	for len(received) < len(sent) {
+		select {
+		case e := <-sub.Events():
+			received = append(received, e)
+		case <-time.After(5 * time.Second): //nolint:mnd
+			t.Fatalf("Received %d of %d events", len(received), len(sent))
+		}
+	}
+	assert.Equal(t, sent, received)
+}
+
+func TestIdempotencyLease(t *testing.T) {
+	repo := &repository.Idempotency{Pool: startDatabase(t)}
+	ctx := t.Context()
+	key := model.IdempotencyKey{Key: "default:k1:retry-1", Route: "POST /pullRequest/create"}
+	hash := []byte("hash")
+	const lease = 100 * time.Millisecond
+
+	_, reserved, err := repo.Reserve(ctx, key, hash, time.Hour, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	// Request within lease is in progress.
+	saved, reserved, err := repo.Reserve(ctx, key, hash, time.Hour, lease)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Nil(t, saved.Response)
+
+	// Request that outlived lease without response is taken over.
+	time.Sleep(2 * lease)
+	_, reserved, err = repo.Reserve(ctx, key, hash, time.Hour, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	// Completed request is replayed until ttl, however old it is.
+	resp := model.StoredResponse{Status: http.StatusCreated, ContentType: "application/json", Body: []byte("{}")}
+	require.NoError(t, repo.Complete(ctx, key, resp))
+	time.Sleep(2 * lease)
+	saved, reserved, err = repo.Reserve(ctx, key, hash, time.Hour, lease)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Equal(t, &resp, saved.Response)
+}